@@ -2,13 +2,16 @@ package cmd
 
 import (
 	"fmt"
-	"github.com/spf13/cobra"
 	"os"
-	"os/exec"
 	"strings"
 
 	"github.com/fatih/color"
 	"github.com/nghiadaulau/opsbrew/internal/config"
+	"github.com/nghiadaulau/opsbrew/internal/exitcode"
+	"github.com/nghiadaulau/opsbrew/internal/i18n"
+	"github.com/nghiadaulau/opsbrew/internal/prompt"
+	"github.com/nghiadaulau/opsbrew/internal/runner"
+	"github.com/spf13/cobra"
 )
 
 var brewCmd = &cobra.Command{
@@ -25,41 +28,28 @@ Available commands:
 }
 
 var brewSaveCmd = &cobra.Command{
-	Use:   "save [name]",
+	Use:   "save <name>",
 	Short: "Save a new recipe",
+	Args:  requireArgs(1, "recipe name is required"),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		if len(args) == 0 {
-			return fmt.Errorf("recipe name is required")
-		}
-
 		name := args[0]
 		description, _ := cmd.Flags().GetString("description")
 		tags, _ := cmd.Flags().GetStringSlice("tags")
 
 		// Get commands from user
-		fmt.Printf("Enter commands for recipe '%s' (one per line, empty line to finish):\n", name)
-		var commands []string
-		for {
-			fmt.Print("> ")
-			var input string
-			if _, err := fmt.Scanln(&input); err != nil {
-				color.Red("Error reading input: %v", err)
-				return err
-			}
-			if input == "" {
-				break
-			}
-			commands = append(commands, input)
+		commands, err := prompt.Lines(i18n.Sprintf("Enter commands for recipe '%s' (one per line, empty line to finish):", name), nil)
+		if err != nil {
+			return err
 		}
 
 		if len(commands) == 0 {
-			return fmt.Errorf("no commands provided")
+			return exitcode.Errorf(exitcode.Validation, "no commands provided")
 		}
 
 		// Load current config
 		cfg, err := config.GetRepoConfig()
 		if err != nil {
-			return fmt.Errorf("failed to load config: %w", err)
+			return i18n.Errorf("failed to load config: %w", err)
 		}
 
 		// Add recipe
@@ -71,10 +61,10 @@ var brewSaveCmd = &cobra.Command{
 
 		// Save config
 		if err := config.SaveConfig(cfg); err != nil {
-			return fmt.Errorf("failed to save recipe: %w", err)
+			return i18n.Errorf("failed to save recipe: %w", err)
 		}
 
-		color.Green("Recipe '%s' saved successfully", name)
+		color.Green(i18n.T("Recipe '%s' saved successfully"), name)
 		return nil
 	},
 }
@@ -85,23 +75,23 @@ var brewListCmd = &cobra.Command{
 	RunE: func(cmd *cobra.Command, args []string) error {
 		cfg, err := config.GetRepoConfig()
 		if err != nil {
-			return fmt.Errorf("failed to load config: %w", err)
+			return i18n.Errorf("failed to load config: %w", err)
 		}
 
 		if len(cfg.Brew.Recipes) == 0 {
-			color.Yellow("No recipes found")
+			color.Yellow(i18n.T("No recipes found"))
 			return nil
 		}
 
-		fmt.Println("=== Saved Recipes ===")
+		fmt.Println(i18n.T("=== Saved Recipes ==="))
 		for name, recipe := range cfg.Brew.Recipes {
 			color.Cyan("  %s", name)
 			if recipe.Description != "" {
-				fmt.Printf("    Description: %s\n", recipe.Description)
+				fmt.Printf(i18n.T("    Description: %s\n"), recipe.Description)
 			}
-			fmt.Printf("    Commands: %d\n", len(recipe.Commands))
+			fmt.Printf(i18n.T("    Commands: %d\n"), len(recipe.Commands))
 			if len(recipe.Tags) > 0 {
-				fmt.Printf("    Tags: %s\n", strings.Join(recipe.Tags, ", "))
+				fmt.Printf(i18n.T("    Tags: %s\n"), strings.Join(recipe.Tags, ", "))
 			}
 			fmt.Println()
 		}
@@ -111,26 +101,24 @@ var brewListCmd = &cobra.Command{
 }
 
 var brewRunCmd = &cobra.Command{
-	Use:   "run [name]",
-	Short: "Run a saved recipe",
+	Use:               "run <name>",
+	Short:             "Run a saved recipe",
+	ValidArgsFunction: firstArgOnly(completeRecipes),
+	Args:              requireArgs(1, "recipe name is required"),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		if len(args) == 0 {
-			return fmt.Errorf("recipe name is required")
-		}
-
 		name := args[0]
 		cfg, err := config.GetRepoConfig()
 		if err != nil {
-			return fmt.Errorf("failed to load config: %w", err)
+			return i18n.Errorf("failed to load config: %w", err)
 		}
 
 		recipe, exists := cfg.Brew.Recipes[name]
 		if !exists {
-			return fmt.Errorf("recipe '%s' not found", name)
+			return exitcode.Errorf(exitcode.Validation, "recipe '%s' not found", name)
 		}
 
 		if dryRun {
-			color.Yellow("Would run recipe '%s':", name)
+			color.Yellow(i18n.T("Would run recipe '%s':"), name)
 			for i, command := range recipe.Commands {
 				color.Yellow("  %d. %s", i+1, command)
 			}
@@ -138,28 +126,19 @@ var brewRunCmd = &cobra.Command{
 		}
 
 		// Check if we need confirmation
-		if !confirm && !cfg.UI.Confirm {
-			fmt.Printf("Run recipe '%s'? (y/N): ", name)
-			var response string
-			if _, err := fmt.Scanln(&response); err != nil {
-				color.Red("Error reading input: %v", err)
-				return err
-			}
-			if strings.ToLower(response) != "y" && strings.ToLower(response) != "yes" {
-				color.Yellow("Operation cancelled")
-				return nil
-			}
+		if err := confirmAction(cfg, i18n.Sprintf("Run recipe '%s'?", name)); err != nil {
+			return err
 		}
 
-		color.Green("Running recipe: %s", name)
+		color.Green(i18n.T("Running recipe: %s"), name)
 		if recipe.Description != "" {
-			fmt.Printf("Description: %s\n", recipe.Description)
+			fmt.Printf(i18n.T("Description: %s\n"), recipe.Description)
 		}
 		fmt.Println()
 
 		// Execute commands
 		for i, command := range recipe.Commands {
-			color.Cyan("Executing command %d/%d: %s", i+1, len(recipe.Commands), command)
+			color.Cyan(i18n.T("Executing command %d/%d: %s"), i+1, len(recipe.Commands), command)
 
 			// Split command into parts
 			parts := strings.Fields(command)
@@ -167,154 +146,122 @@ var brewRunCmd = &cobra.Command{
 				continue
 			}
 
-			cmdExec := exec.Command(parts[0], parts[1:]...)
+			cmdExec := runner.Command(parts[0], parts[1:]...)
 			cmdExec.Stdout = os.Stdout
 			cmdExec.Stderr = os.Stderr
 			cmdExec.Stdin = os.Stdin
 
 			if err := cmdExec.Run(); err != nil {
-				color.Red("Command failed: %s", command)
-				return fmt.Errorf("recipe execution failed: %w", err)
+				color.Red(i18n.T("Command failed: %s"), command)
+				return i18n.Errorf("recipe execution failed: %w", err)
 			}
 
 			fmt.Println()
 		}
 
-		color.Green("Recipe '%s' completed successfully", name)
+		color.Green(i18n.T("Recipe '%s' completed successfully"), name)
 		return nil
 	},
 }
 
 var brewDeleteCmd = &cobra.Command{
-	Use:   "delete [name]",
-	Short: "Delete a saved recipe",
+	Use:               "delete <name>",
+	Short:             "Delete a saved recipe",
+	ValidArgsFunction: firstArgOnly(completeRecipes),
+	Args:              requireArgs(1, "recipe name is required"),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		if len(args) == 0 {
-			return fmt.Errorf("recipe name is required")
-		}
-
 		name := args[0]
 		cfg, err := config.GetRepoConfig()
 		if err != nil {
-			return fmt.Errorf("failed to load config: %w", err)
+			return i18n.Errorf("failed to load config: %w", err)
 		}
 
 		if _, exists := cfg.Brew.Recipes[name]; !exists {
-			return fmt.Errorf("recipe '%s' not found", name)
+			return exitcode.Errorf(exitcode.Validation, "recipe '%s' not found", name)
 		}
 
 		if dryRun {
-			color.Yellow("Would delete recipe: %s", name)
+			color.Yellow(i18n.T("Would delete recipe: %s"), name)
 			return nil
 		}
 
 		// Check if we need confirmation
-		if !confirm && !cfg.UI.Confirm {
-			fmt.Printf("Delete recipe '%s'? (y/N): ", name)
-			var response string
-			if _, err := fmt.Scanln(&response); err != nil {
-				color.Red("Error reading input: %v", err)
-				return err
-			}
-			if strings.ToLower(response) != "y" && strings.ToLower(response) != "yes" {
-				color.Yellow("Operation cancelled")
-				return nil
-			}
+		if err := confirmAction(cfg, i18n.Sprintf("Delete recipe '%s'? This cannot be undone.", name)); err != nil {
+			return err
 		}
 
 		delete(cfg.Brew.Recipes, name)
 
 		if err := config.SaveConfig(cfg); err != nil {
-			return fmt.Errorf("failed to delete recipe: %w", err)
+			return i18n.Errorf("failed to delete recipe: %w", err)
 		}
 
-		color.Green("Recipe '%s' deleted successfully", name)
+		color.Green(i18n.T("Recipe '%s' deleted successfully"), name)
 		return nil
 	},
 }
 
 var brewEditCmd = &cobra.Command{
-	Use:   "edit [name]",
-	Short: "Edit a saved recipe",
+	Use:               "edit <name>",
+	Short:             "Edit a saved recipe",
+	ValidArgsFunction: firstArgOnly(completeRecipes),
+	Args:              requireArgs(1, "recipe name is required"),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		if len(args) == 0 {
-			return fmt.Errorf("recipe name is required")
-		}
-
 		name := args[0]
 		cfg, err := config.GetRepoConfig()
 		if err != nil {
-			return fmt.Errorf("failed to load config: %w", err)
+			return i18n.Errorf("failed to load config: %w", err)
 		}
 
 		recipe, exists := cfg.Brew.Recipes[name]
 		if !exists {
-			return fmt.Errorf("recipe '%s' not found", name)
+			return exitcode.Errorf(exitcode.Validation, "recipe '%s' not found", name)
 		}
 
 		// Show current recipe
-		fmt.Printf("Current recipe '%s':\n", name)
-		fmt.Printf("Description: %s\n", recipe.Description)
-		fmt.Printf("Tags: %s\n", strings.Join(recipe.Tags, ", "))
-		fmt.Println("Commands:")
+		fmt.Printf(i18n.T("Current recipe '%s':\n"), name)
+		fmt.Printf(i18n.T("Description: %s\n"), recipe.Description)
+		fmt.Printf(i18n.T("Tags: %s\n"), strings.Join(recipe.Tags, ", "))
+		fmt.Println(i18n.T("Commands:"))
 		for i, command := range recipe.Commands {
 			fmt.Printf("  %d. %s\n", i+1, command)
 		}
 		fmt.Println()
 
 		// Get new description
-		fmt.Print("New description (press Enter to keep current): ")
-		var newDescription string
-		if _, err := fmt.Scanln(&newDescription); err != nil {
-			color.Red("Error reading input: %v", err)
+		newDescription, err := prompt.Input(i18n.T("New description (press Enter to keep current)"), recipe.Description, nil)
+		if err != nil {
 			return err
 		}
-		if newDescription != "" {
-			recipe.Description = newDescription
-		}
+		recipe.Description = newDescription
 
 		// Get new tags
-		fmt.Print("New tags (comma-separated, press Enter to keep current): ")
-		var newTags string
-		if _, err := fmt.Scanln(&newTags); err != nil {
-			color.Red("Error reading input: %v", err)
+		newTags, err := prompt.Input(i18n.T("New tags (comma-separated, press Enter to keep current)"), strings.Join(recipe.Tags, ", "), nil)
+		if err != nil {
 			return err
 		}
-		if newTags != "" {
-			recipe.Tags = strings.Split(newTags, ",")
-			for i, tag := range recipe.Tags {
-				recipe.Tags[i] = strings.TrimSpace(tag)
+		recipe.Tags = nil
+		for _, tag := range strings.Split(newTags, ",") {
+			if tag = strings.TrimSpace(tag); tag != "" {
+				recipe.Tags = append(recipe.Tags, tag)
 			}
 		}
 
 		// Get new commands
-		fmt.Println("Enter new commands (one per line, empty line to finish):")
-		var newCommands []string
-		for {
-			fmt.Print("> ")
-			var input string
-			if _, err := fmt.Scanln(&input); err != nil {
-				color.Red("Error reading input: %v", err)
-				return err
-			}
-			if input == "" {
-				break
-			}
-			newCommands = append(newCommands, input)
-		}
-
-		if len(newCommands) > 0 {
-			recipe.Commands = newCommands
+		newCommands, err := prompt.Lines(i18n.T("Enter new commands (one per line, empty line to keep current):"), recipe.Commands)
+		if err != nil {
+			return err
 		}
+		recipe.Commands = newCommands
 
 		// Save updated recipe
 		cfg.Brew.Recipes[name] = recipe
 
 		if err := config.SaveConfig(cfg); err != nil {
-			return fmt.Errorf("failed to save recipe: %w", err)
+			return i18n.Errorf("failed to save recipe: %w", err)
 		}
 
-		color.Green("Recipe '%s' updated successfully", name)
+		color.Green(i18n.T("Recipe '%s' updated successfully"), name)
 		return nil
 	},
 }
@@ -2,7 +2,14 @@ package cmd
 
 import (
 	"os"
+	"sort"
+	"strings"
 
+	"github.com/nghiadaulau/opsbrew/internal/cache"
+	"github.com/nghiadaulau/opsbrew/internal/config"
+	"github.com/nghiadaulau/opsbrew/internal/git"
+	"github.com/nghiadaulau/opsbrew/internal/kubernetes"
+	"github.com/nghiadaulau/opsbrew/internal/templates"
 	"github.com/spf13/cobra"
 )
 
@@ -72,3 +79,148 @@ PowerShell:
 func init() {
 	rootCmd.AddCommand(completionCmd)
 }
+
+// completionConfig loads config for shell completion, falling back to an empty config
+func completionConfig() *config.Config {
+	cfg, err := config.GetRepoConfig()
+	if err != nil {
+		return &config.Config{}
+	}
+	return cfg
+}
+
+// filterPrefix returns the sorted, de-duplicated candidates starting with prefix
+func filterPrefix(candidates []string, prefix string) []string {
+	seen := map[string]bool{}
+	var matches []string
+	for _, c := range candidates {
+		if c == "" || seen[c] || !strings.HasPrefix(c, prefix) {
+			continue
+		}
+		seen[c] = true
+		matches = append(matches, c)
+	}
+	sort.Strings(matches)
+	return matches
+}
+
+// firstArgOnly wraps a completion function so it only completes the first argument
+func firstArgOnly(fn func(toComplete string) []string) func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) > 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		return fn(toComplete), cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// completeContexts suggests kubectl contexts and configured context aliases
+func completeContexts(toComplete string) []string {
+	candidates, _ := cache.Fetch("contexts", cache.DefaultTTL, func() ([]string, error) {
+		contexts, err := kubernetes.GetContexts()
+		if err != nil {
+			return nil, err
+		}
+		var names []string
+		for _, ctx := range contexts {
+			names = append(names, ctx.Name)
+		}
+		return names, nil
+	})
+
+	for alias := range completionConfig().Kubernetes.ContextAliases {
+		candidates = append(candidates, alias)
+	}
+
+	return filterPrefix(candidates, toComplete)
+}
+
+// completeNamespaces suggests namespaces of the current context and configured namespace aliases
+func completeNamespaces(toComplete string) []string {
+	var candidates []string
+	if context, err := kubernetes.GetCurrentContext(); err == nil {
+		candidates, _ = cache.Fetch("namespaces-"+context, cache.DefaultTTL, func() ([]string, error) {
+			namespaces, err := kubernetes.GetNamespaces()
+			if err != nil {
+				return nil, err
+			}
+			var names []string
+			for _, ns := range namespaces {
+				names = append(names, ns.Name)
+			}
+			return names, nil
+		})
+	}
+
+	for alias := range completionConfig().Kubernetes.NamespaceAliases {
+		candidates = append(candidates, alias)
+	}
+
+	return filterPrefix(candidates, toComplete)
+}
+
+// completePods suggests pods in the current namespace
+func completePods(toComplete string) []string {
+	context, err := kubernetes.GetCurrentContext()
+	if err != nil {
+		return nil
+	}
+	namespace, err := kubernetes.GetCurrentNamespace()
+	if err != nil {
+		return nil
+	}
+
+	candidates, _ := cache.Fetch("pods-"+context+"-"+namespace, cache.DefaultTTL, func() ([]string, error) {
+		pods, err := kubernetes.GetPods()
+		if err != nil {
+			return nil, err
+		}
+		var names []string
+		for _, pod := range pods {
+			names = append(names, pod.Name)
+		}
+		return names, nil
+	})
+
+	return filterPrefix(candidates, toComplete)
+}
+
+// completeBranches suggests local and remote git branches
+func completeBranches(toComplete string) []string {
+	branches, err := git.GetBranches()
+	if err != nil {
+		return nil
+	}
+
+	var candidates []string
+	for _, branch := range branches {
+		candidates = append(candidates, branch.Name)
+	}
+
+	return filterPrefix(candidates, toComplete)
+}
+
+// completeRecipes suggests saved recipe names
+func completeRecipes(toComplete string) []string {
+	var candidates []string
+	for name := range completionConfig().Brew.Recipes {
+		candidates = append(candidates, name)
+	}
+
+	return filterPrefix(candidates, toComplete)
+}
+
+// completeTemplates suggests available template names
+func completeTemplates(toComplete string) []string {
+	var candidates []string
+	for _, t := range templates.GetAvailableTemplates() {
+		candidates = append(candidates, t.Name)
+	}
+
+	return filterPrefix(candidates, toComplete)
+}
+
+// completeNamespaceFlag completes the --namespace flag
+func completeNamespaceFlag(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return completeNamespaces(toComplete), cobra.ShellCompDirectiveNoFileComp
+}
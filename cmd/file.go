@@ -3,11 +3,13 @@ package cmd
 import (
 	"fmt"
 	"os"
-	"os/exec"
 	"runtime"
 	"strings"
 
 	"github.com/fatih/color"
+	"github.com/nghiadaulau/opsbrew/internal/exitcode"
+	"github.com/nghiadaulau/opsbrew/internal/i18n"
+	"github.com/nghiadaulau/opsbrew/internal/runner"
 	"github.com/spf13/cobra"
 )
 
@@ -25,55 +27,49 @@ Available commands:
 }
 
 var fileOpenCmd = &cobra.Command{
-	Use:   "open [file]",
+	Use:   "open <file>",
 	Short: "Open file with default editor",
+	Args:  requireArgs(1, "file path is required"),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		if len(args) == 0 {
-			return fmt.Errorf("file path is required")
-		}
-
 		filePath := args[0]
 
 		if dryRun {
-			color.Yellow("Would open file: %s", filePath)
+			color.Yellow(i18n.T("Would open file: %s"), filePath)
 			return nil
 		}
 
 		// Check if file exists
 		if _, err := os.Stat(filePath); os.IsNotExist(err) {
-			return fmt.Errorf("file %s does not exist", filePath)
+			return exitcode.Errorf(exitcode.Validation, "file %s does not exist", filePath)
 		}
 
 		// Try to open with default editor
-		var cmdExec *exec.Cmd
+		var cmdExec *runner.Cmd
 		switch os := runtime.GOOS; os {
 		case "darwin":
-			cmdExec = exec.Command("open", filePath)
+			cmdExec = runner.Command("open", filePath)
 		case "linux":
-			cmdExec = exec.Command("xdg-open", filePath)
+			cmdExec = runner.Command("xdg-open", filePath)
 		case "windows":
-			cmdExec = exec.Command("cmd", "/c", "start", filePath)
+			cmdExec = runner.Command("cmd", "/c", "start", filePath)
 		default:
-			return fmt.Errorf("unsupported operating system: %s", os)
+			return i18n.Errorf("unsupported operating system: %s", os)
 		}
 
 		if err := cmdExec.Run(); err != nil {
-			return fmt.Errorf("failed to open file: %w", err)
+			return i18n.Errorf("failed to open file: %w", err)
 		}
 
-		color.Green("Opened file: %s", filePath)
+		color.Green(i18n.T("Opened file: %s"), filePath)
 		return nil
 	},
 }
 
 var fileFindCmd = &cobra.Command{
-	Use:   "find [pattern]",
+	Use:   "find <pattern> [dir]",
 	Short: "Find files by name or pattern",
+	Args:  requireArgs(1, "search pattern is required"),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		if len(args) == 0 {
-			return fmt.Errorf("search pattern is required")
-		}
-
 		pattern := args[0]
 		dir := "."
 		if len(args) > 1 {
@@ -81,24 +77,24 @@ var fileFindCmd = &cobra.Command{
 		}
 
 		if dryRun {
-			color.Yellow("Would search for pattern '%s' in directory '%s'", pattern, dir)
+			color.Yellow(i18n.T("Would search for pattern '%s' in directory '%s'"), pattern, dir)
 			return nil
 		}
 
 		// Use find command
-		cmdExec := exec.Command("find", dir, "-name", pattern, "-type", "f")
+		cmdExec := runner.Command("find", dir, "-name", pattern, "-type", "f")
 		output, err := cmdExec.Output()
 		if err != nil {
-			return fmt.Errorf("failed to find files: %w", err)
+			return i18n.Errorf("failed to find files: %w", err)
 		}
 
 		files := strings.Split(strings.TrimSpace(string(output)), "\n")
 		if len(files) == 0 || (len(files) == 1 && files[0] == "") {
-			color.Yellow("No files found matching pattern: %s", pattern)
+			color.Yellow(i18n.T("No files found matching pattern: %s"), pattern)
 			return nil
 		}
 
-		color.Green("Found %d files:", len(files))
+		color.Green(i18n.T("Found %d files:"), len(files))
 		for _, file := range files {
 			if file != "" {
 				fmt.Printf("  %s\n", file)
@@ -110,38 +106,35 @@ var fileFindCmd = &cobra.Command{
 }
 
 var fileGrepCmd = &cobra.Command{
-	Use:   "grep [pattern] [file]",
+	Use:   "grep <pattern> <file>",
 	Short: "Search for text in files",
+	Args:  requireArgs(2, "search pattern and file path are required"),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		if len(args) < 2 {
-			return fmt.Errorf("search pattern and file path are required")
-		}
-
 		pattern := args[0]
 		filePath := args[1]
 
 		if dryRun {
-			color.Yellow("Would search for '%s' in file '%s'", pattern, filePath)
+			color.Yellow(i18n.T("Would search for '%s' in file '%s'"), pattern, filePath)
 			return nil
 		}
 
 		// Check if file exists
 		if _, err := os.Stat(filePath); os.IsNotExist(err) {
-			return fmt.Errorf("file %s does not exist", filePath)
+			return exitcode.Errorf(exitcode.Validation, "file %s does not exist", filePath)
 		}
 
 		// Use grep command
-		cmdExec := exec.Command("grep", "-n", pattern, filePath)
+		cmdExec := runner.Command("grep", "-n", pattern, filePath)
 		cmdExec.Stdout = os.Stdout
 		cmdExec.Stderr = os.Stderr
 
 		if err := cmdExec.Run(); err != nil {
 			// grep returns exit code 1 when no matches found
 			if strings.Contains(err.Error(), "exit status 1") {
-				color.Yellow("No matches found for pattern: %s", pattern)
+				color.Yellow(i18n.T("No matches found for pattern: %s"), pattern)
 				return nil
 			}
-			return fmt.Errorf("failed to search file: %w", err)
+			return i18n.Errorf("failed to search file: %w", err)
 		}
 
 		return nil
@@ -149,23 +142,20 @@ var fileGrepCmd = &cobra.Command{
 }
 
 var fileBackupCmd = &cobra.Command{
-	Use:   "backup [file]",
+	Use:   "backup <file> [backup-path]",
 	Short: "Create backup of file",
+	Args:  requireArgs(1, "file path is required"),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		if len(args) == 0 {
-			return fmt.Errorf("file path is required")
-		}
-
 		filePath := args[0]
 
 		if dryRun {
-			color.Yellow("Would create backup of file: %s", filePath)
+			color.Yellow(i18n.T("Would create backup of file: %s"), filePath)
 			return nil
 		}
 
 		// Check if file exists
 		if _, err := os.Stat(filePath); os.IsNotExist(err) {
-			return fmt.Errorf("file %s does not exist", filePath)
+			return exitcode.Errorf(exitcode.Validation, "file %s does not exist", filePath)
 		}
 
 		// Create backup filename
@@ -175,42 +165,39 @@ var fileBackupCmd = &cobra.Command{
 		}
 
 		// Copy file
-		cmdExec := exec.Command("cp", filePath, backupPath)
+		cmdExec := runner.Command("cp", filePath, backupPath)
 		if err := cmdExec.Run(); err != nil {
-			return fmt.Errorf("failed to create backup: %w", err)
+			return i18n.Errorf("failed to create backup: %w", err)
 		}
 
-		color.Green("Created backup: %s", backupPath)
+		color.Green(i18n.T("Created backup: %s"), backupPath)
 		return nil
 	},
 }
 
 var fileDiffCmd = &cobra.Command{
-	Use:   "diff [file1] [file2]",
+	Use:   "diff <file1> <file2>",
 	Short: "Show differences between files",
+	Args:  requireArgs(2, "two file paths are required"),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		if len(args) < 2 {
-			return fmt.Errorf("two file paths are required")
-		}
-
 		file1 := args[0]
 		file2 := args[1]
 
 		if dryRun {
-			color.Yellow("Would show diff between '%s' and '%s'", file1, file2)
+			color.Yellow(i18n.T("Would show diff between '%s' and '%s'"), file1, file2)
 			return nil
 		}
 
 		// Check if files exist
 		if _, err := os.Stat(file1); os.IsNotExist(err) {
-			return fmt.Errorf("file %s does not exist", file1)
+			return exitcode.Errorf(exitcode.Validation, "file %s does not exist", file1)
 		}
 		if _, err := os.Stat(file2); os.IsNotExist(err) {
-			return fmt.Errorf("file %s does not exist", file2)
+			return exitcode.Errorf(exitcode.Validation, "file %s does not exist", file2)
 		}
 
 		// Use diff command
-		cmdExec := exec.Command("diff", file1, file2)
+		cmdExec := runner.Command("diff", file1, file2)
 		cmdExec.Stdout = os.Stdout
 		cmdExec.Stderr = os.Stderr
 
@@ -220,10 +207,10 @@ var fileDiffCmd = &cobra.Command{
 				// This is normal for different files
 				return nil
 			}
-			return fmt.Errorf("failed to compare files: %w", err)
+			return i18n.Errorf("failed to compare files: %w", err)
 		}
 
-		color.Green("Files are identical")
+		color.Green(i18n.T("Files are identical"))
 		return nil
 	},
 }
@@ -1,14 +1,15 @@
 package cmd
 
 import (
-	"fmt"
 	"os"
-	"os/exec"
 	"strings"
 
 	"github.com/fatih/color"
 	"github.com/nghiadaulau/opsbrew/internal/config"
 	"github.com/nghiadaulau/opsbrew/internal/git"
+	"github.com/nghiadaulau/opsbrew/internal/i18n"
+	"github.com/nghiadaulau/opsbrew/internal/prompt"
+	"github.com/nghiadaulau/opsbrew/internal/runner"
 	"github.com/spf13/cobra"
 )
 
@@ -33,18 +34,18 @@ var gitStatusCmd = &cobra.Command{
 	RunE: func(cmd *cobra.Command, args []string) error {
 		cfg, err := config.GetRepoConfig()
 		if err != nil {
-			return fmt.Errorf("failed to load config: %w", err)
+			return i18n.Errorf("failed to load config: %w", err)
 		}
 
 		if dryRun {
-			color.Yellow("Would run: git status")
+			color.Yellow(i18n.T("Would run: git status"))
 			return nil
 		}
 
 		// Run git status
-		output, err := exec.Command("git", "status", "--porcelain").Output()
+		output, err := runner.Command("git", "status", "--porcelain").Output()
 		if err != nil {
-			return fmt.Errorf("failed to get git status: %w", err)
+			return i18n.Errorf("failed to get git status: %w", err)
 		}
 
 		// Parse and display status
@@ -61,59 +62,51 @@ var gitSyncCmd = &cobra.Command{
 	RunE: func(cmd *cobra.Command, args []string) error {
 		cfg, err := config.GetRepoConfig()
 		if err != nil {
-			return fmt.Errorf("failed to load config: %w", err)
+			return i18n.Errorf("failed to load config: %w", err)
 		}
 
 		if dryRun {
-			color.Yellow("Would run: git pull --rebase")
+			color.Yellow(i18n.T("Would run: git pull --rebase"))
 			return nil
 		}
 
 		// Check if we need confirmation
-		if !confirm && !cfg.UI.Confirm {
-			fmt.Print("Pull with rebase? (y/N): ")
-			var response string
-			if _, err := fmt.Scanln(&response); err != nil {
-				color.Red("Error reading input: %v", err)
-				return err
-			}
-			if strings.ToLower(response) != "y" && strings.ToLower(response) != "yes" {
-				color.Yellow("Operation cancelled")
-				return nil
-			}
+		if err := confirmAction(cfg, i18n.T("Pull with rebase?")); err != nil {
+			return err
 		}
 
 		// Get current branch
-		branchOutput, err := exec.Command("git", "branch", "--show-current").Output()
+		branchOutput, err := runner.Command("git", "branch", "--show-current").Output()
 		if err != nil {
-			return fmt.Errorf("failed to get current branch: %w", err)
+			return i18n.Errorf("failed to get current branch: %w", err)
 		}
 		currentBranch := strings.TrimSpace(string(branchOutput))
 
-		color.Green("Syncing branch: %s", currentBranch)
+		color.Green(i18n.T("Syncing branch: %s"), currentBranch)
 
 		// Run git pull --rebase
-		cmdExec := exec.Command("git", "pull", "--rebase")
+		cmdExec := runner.Command("git", "pull", "--rebase")
 		cmdExec.Stdout = os.Stdout
 		cmdExec.Stderr = os.Stderr
 		cmdExec.Stdin = os.Stdin
 
 		if err := cmdExec.Run(); err != nil {
-			return fmt.Errorf("failed to sync: %w", err)
+			return i18n.Errorf("failed to sync: %w", err)
 		}
 
-		color.Green("Sync completed successfully")
+		color.Green(i18n.T("Sync completed successfully"))
 		return nil
 	},
 }
 
 var gitCheckoutCmd = &cobra.Command{
-	Use:   "checkout [branch]",
-	Short: "Checkout branch with fuzzy finder",
+	Use:               "checkout [branch]",
+	Short:             "Checkout branch with fuzzy finder",
+	ValidArgsFunction: firstArgOnly(completeBranches),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		_, err := config.GetRepoConfig()
 		if err != nil {
-			return fmt.Errorf("failed to load config: %w", err)
+			return i18n.Errorf("failed to load config: %w", err)
 		}
 
 		var targetBranch string
@@ -122,45 +115,48 @@ var gitCheckoutCmd = &cobra.Command{
 			targetBranch = args[0]
 		} else {
 			// Use fuzzy finder to select branch
+			if err := prompt.RequireInteractive(i18n.T("branch")); err != nil {
+				return err
+			}
 			branches, err := git.GetBranches()
 			if err != nil {
-				return fmt.Errorf("failed to get branches: %w", err)
+				return i18n.Errorf("failed to get branches: %w", err)
 			}
 
 			selected, err := git.SelectBranch(branches)
 			if err != nil {
-				return fmt.Errorf("failed to select branch: %w", err)
+				return i18n.Errorf("failed to select branch: %w", err)
 			}
 			targetBranch = selected
 		}
 
 		if dryRun {
-			color.Yellow("Would run: git checkout %s", targetBranch)
+			color.Yellow(i18n.T("Would run: git checkout %s"), targetBranch)
 			return nil
 		}
 
 		// Check if branch exists locally
-		_, err = exec.Command("git", "show-ref", "--verify", "--quiet", "refs/heads/"+targetBranch).Output()
+		_, err = runner.Command("git", "show-ref", "--verify", "--quiet", "refs/heads/"+targetBranch).Output()
 		if err != nil {
 			// Branch doesn't exist locally, try to checkout from remote
-			color.Yellow("Branch %s not found locally, checking out from remote...", targetBranch)
-			cmdExec := exec.Command("git", "checkout", "-b", targetBranch, "origin/"+targetBranch)
+			color.Yellow(i18n.T("Branch %s not found locally, checking out from remote..."), targetBranch)
+			cmdExec := runner.Command("git", "checkout", "-b", targetBranch, "origin/"+targetBranch)
 			cmdExec.Stdout = os.Stdout
 			cmdExec.Stderr = os.Stderr
 			if err := cmdExec.Run(); err != nil {
-				return fmt.Errorf("failed to checkout branch %s: %w", targetBranch, err)
+				return i18n.Errorf("failed to checkout branch %s: %w", targetBranch, err)
 			}
 		} else {
 			// Branch exists locally
-			cmdExec := exec.Command("git", "checkout", targetBranch)
+			cmdExec := runner.Command("git", "checkout", targetBranch)
 			cmdExec.Stdout = os.Stdout
 			cmdExec.Stderr = os.Stderr
 			if err := cmdExec.Run(); err != nil {
-				return fmt.Errorf("failed to checkout branch %s: %w", targetBranch, err)
+				return i18n.Errorf("failed to checkout branch %s: %w", targetBranch, err)
 			}
 		}
 
-		color.Green("Switched to branch: %s", targetBranch)
+		color.Green(i18n.T("Switched to branch: %s"), targetBranch)
 		return nil
 	},
 }
@@ -171,7 +167,7 @@ var gitBranchCmd = &cobra.Command{
 	RunE: func(cmd *cobra.Command, args []string) error {
 		branches, err := git.GetBranches()
 		if err != nil {
-			return fmt.Errorf("failed to get branches: %w", err)
+			return i18n.Errorf("failed to get branches: %w", err)
 		}
 
 		git.DisplayBranches(branches)
@@ -184,20 +180,20 @@ var gitFetchCmd = &cobra.Command{
 	Short: "Fetch all remotes",
 	RunE: func(cmd *cobra.Command, args []string) error {
 		if dryRun {
-			color.Yellow("Would run: git fetch --all")
+			color.Yellow(i18n.T("Would run: git fetch --all"))
 			return nil
 		}
 
-		color.Green("Fetching all remotes...")
-		cmdExec := exec.Command("git", "fetch", "--all")
+		color.Green(i18n.T("Fetching all remotes..."))
+		cmdExec := runner.Command("git", "fetch", "--all")
 		cmdExec.Stdout = os.Stdout
 		cmdExec.Stderr = os.Stderr
 
 		if err := cmdExec.Run(); err != nil {
-			return fmt.Errorf("failed to fetch: %w", err)
+			return i18n.Errorf("failed to fetch: %w", err)
 		}
 
-		color.Green("Fetch completed successfully")
+		color.Green(i18n.T("Fetch completed successfully"))
 		return nil
 	},
 }
@@ -207,20 +203,20 @@ var gitPullCmd = &cobra.Command{
 	Short: "Pull from current branch",
 	RunE: func(cmd *cobra.Command, args []string) error {
 		if dryRun {
-			color.Yellow("Would run: git pull")
+			color.Yellow(i18n.T("Would run: git pull"))
 			return nil
 		}
 
-		color.Green("Pulling from current branch...")
-		cmdExec := exec.Command("git", "pull")
+		color.Green(i18n.T("Pulling from current branch..."))
+		cmdExec := runner.Command("git", "pull")
 		cmdExec.Stdout = os.Stdout
 		cmdExec.Stderr = os.Stderr
 
 		if err := cmdExec.Run(); err != nil {
-			return fmt.Errorf("failed to pull: %w", err)
+			return i18n.Errorf("failed to pull: %w", err)
 		}
 
-		color.Green("Pull completed successfully")
+		color.Green(i18n.T("Pull completed successfully"))
 		return nil
 	},
 }
@@ -230,20 +226,20 @@ var gitPushCmd = &cobra.Command{
 	Short: "Push to current branch",
 	RunE: func(cmd *cobra.Command, args []string) error {
 		if dryRun {
-			color.Yellow("Would run: git push")
+			color.Yellow(i18n.T("Would run: git push"))
 			return nil
 		}
 
-		color.Green("Pushing to current branch...")
-		cmdExec := exec.Command("git", "push")
+		color.Green(i18n.T("Pushing to current branch..."))
+		cmdExec := runner.Command("git", "push")
 		cmdExec.Stdout = os.Stdout
 		cmdExec.Stderr = os.Stderr
 
 		if err := cmdExec.Run(); err != nil {
-			return fmt.Errorf("failed to push: %w", err)
+			return i18n.Errorf("failed to push: %w", err)
 		}
 
-		color.Green("Push completed successfully")
+		color.Green(i18n.T("Push completed successfully"))
 		return nil
 	},
 }
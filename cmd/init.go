@@ -5,13 +5,15 @@ import (
 
 	"github.com/fatih/color"
 	"github.com/nghiadaulau/opsbrew/internal/config"
+	"github.com/nghiadaulau/opsbrew/internal/i18n"
 	"github.com/nghiadaulau/opsbrew/internal/templates"
 	"github.com/spf13/cobra"
 )
 
 var initCmd = &cobra.Command{
-	Use:   "init [template] [project-name]",
-	Short: "Initialize a new project from template",
+	Use:               "init <template> [project-name]",
+	Short:             "Initialize a new project from template",
+	ValidArgsFunction: firstArgOnly(completeTemplates),
 	Long: `Initialize a new project from available templates.
 
 Available templates:
@@ -21,11 +23,8 @@ Available templates:
   k8s-pod        - Kubernetes Pod manifest
   k8s-configmap  - Kubernetes ConfigMap manifest
   dockerfile     - Multi-stage Dockerfile template`,
+	Args: requireArgs(1, "template name is required"),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		if len(args) < 1 {
-			return fmt.Errorf("template name is required")
-		}
-
 		templateName := args[0]
 		projectName := ""
 		if len(args) > 1 {
@@ -38,26 +37,26 @@ Available templates:
 
 		cfg, err := config.GetRepoConfig()
 		if err != nil {
-			return fmt.Errorf("failed to load config: %w", err)
+			return i18n.Errorf("failed to load config: %w", err)
 		}
 
 		if dryRun {
-			color.Yellow("Would initialize template: %s", templateName)
+			color.Yellow(i18n.T("Would initialize template: %s"), templateName)
 			if projectName != "" {
-				color.Yellow("Project name: %s", projectName)
+				color.Yellow(i18n.T("Project name: %s"), projectName)
 			}
 			if outputDir != "" {
-				color.Yellow("Output directory: %s", outputDir)
+				color.Yellow(i18n.T("Output directory: %s"), outputDir)
 			}
 			return nil
 		}
 
 		// Initialize template
 		if err := templates.InitializeTemplate(templateName, projectName, outputDir, force, cfg); err != nil {
-			return fmt.Errorf("failed to initialize template: %w", err)
+			return i18n.Errorf("failed to initialize template: %w", err)
 		}
 
-		color.Green("Project initialized successfully!")
+		color.Green(i18n.T("Project initialized successfully!"))
 		return nil
 	},
 }
@@ -68,11 +67,11 @@ var initListCmd = &cobra.Command{
 	RunE: func(cmd *cobra.Command, args []string) error {
 		templates := templates.GetAvailableTemplates()
 
-		fmt.Println("=== Available Templates ===")
+		fmt.Println(i18n.T("=== Available Templates ==="))
 		for _, template := range templates {
 			color.Cyan("  %s", template.Name)
-			fmt.Printf("    Description: %s\n", template.Description)
-			fmt.Printf("    Files: %d\n", len(template.Files))
+			fmt.Printf(i18n.T("    Description: %s\n"), template.Description)
+			fmt.Printf(i18n.T("    Files: %d\n"), len(template.Files))
 			fmt.Println()
 		}
 
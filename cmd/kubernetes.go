@@ -3,12 +3,15 @@ package cmd
 import (
 	"fmt"
 	"os"
-	"os/exec"
 	"strings"
 
 	"github.com/fatih/color"
 	"github.com/nghiadaulau/opsbrew/internal/config"
+	"github.com/nghiadaulau/opsbrew/internal/exitcode"
+	"github.com/nghiadaulau/opsbrew/internal/i18n"
 	"github.com/nghiadaulau/opsbrew/internal/kubernetes"
+	"github.com/nghiadaulau/opsbrew/internal/prompt"
+	"github.com/nghiadaulau/opsbrew/internal/runner"
 	"github.com/spf13/cobra"
 )
 
@@ -30,12 +33,13 @@ Available commands:
 }
 
 var kctxCmd = &cobra.Command{
-	Use:   "kctx [context]",
-	Short: "Switch kubectl context with fuzzy finder",
+	Use:               "kctx [context]",
+	Short:             "Switch kubectl context with fuzzy finder",
+	ValidArgsFunction: firstArgOnly(completeContexts),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		cfg, err := config.GetRepoConfig()
 		if err != nil {
-			return fmt.Errorf("failed to load config: %w", err)
+			return i18n.Errorf("failed to load config: %w", err)
 		}
 
 		var targetContext string
@@ -48,44 +52,48 @@ var kctxCmd = &cobra.Command{
 			}
 		} else {
 			// Use fuzzy finder to select context
+			if err := prompt.RequireInteractive(i18n.T("context")); err != nil {
+				return err
+			}
 			contexts, err := kubernetes.GetContexts()
 			if err != nil {
-				return fmt.Errorf("failed to get contexts: %w", err)
+				return i18n.Errorf("failed to get contexts: %w", err)
 			}
 
 			selected, err := kubernetes.SelectContext(contexts)
 			if err != nil {
-				return fmt.Errorf("failed to select context: %w", err)
+				return i18n.Errorf("failed to select context: %w", err)
 			}
 			targetContext = selected
 		}
 
 		if dryRun {
-			color.Yellow("Would run: kubectl config use-context %s", targetContext)
+			color.Yellow(i18n.T("Would run: kubectl config use-context %s"), targetContext)
 			return nil
 		}
 
 		// Switch context
-		cmdExec := exec.Command("kubectl", "config", "use-context", targetContext)
+		cmdExec := runner.Command("kubectl", "config", "use-context", targetContext)
 		cmdExec.Stdout = os.Stdout
 		cmdExec.Stderr = os.Stderr
 
 		if err := cmdExec.Run(); err != nil {
-			return fmt.Errorf("failed to switch context: %w", err)
+			return i18n.Errorf("failed to switch context: %w", err)
 		}
 
-		color.Green("Switched to context: %s", targetContext)
+		color.Green(i18n.T("Switched to context: %s"), targetContext)
 		return nil
 	},
 }
 
 var knsCmd = &cobra.Command{
-	Use:   "kns [namespace]",
-	Short: "Switch kubectl namespace with fuzzy finder",
+	Use:               "kns [namespace]",
+	Short:             "Switch kubectl namespace with fuzzy finder",
+	ValidArgsFunction: firstArgOnly(completeNamespaces),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		cfg, err := config.GetRepoConfig()
 		if err != nil {
-			return fmt.Errorf("failed to load config: %w", err)
+			return i18n.Errorf("failed to load config: %w", err)
 		}
 
 		var targetNamespace string
@@ -98,40 +106,44 @@ var knsCmd = &cobra.Command{
 			}
 		} else {
 			// Use fuzzy finder to select namespace
+			if err := prompt.RequireInteractive(i18n.T("namespace")); err != nil {
+				return err
+			}
 			namespaces, err := kubernetes.GetNamespaces()
 			if err != nil {
-				return fmt.Errorf("failed to get namespaces: %w", err)
+				return i18n.Errorf("failed to get namespaces: %w", err)
 			}
 
 			selected, err := kubernetes.SelectNamespace(namespaces)
 			if err != nil {
-				return fmt.Errorf("failed to select namespace: %w", err)
+				return i18n.Errorf("failed to select namespace: %w", err)
 			}
 			targetNamespace = selected
 		}
 
 		if dryRun {
-			color.Yellow("Would run: kubectl config set-context --current --namespace=%s", targetNamespace)
+			color.Yellow(i18n.T("Would run: kubectl config set-context --current --namespace=%s"), targetNamespace)
 			return nil
 		}
 
 		// Switch namespace
-		cmdExec := exec.Command("kubectl", "config", "set-context", "--current", "--namespace="+targetNamespace)
+		cmdExec := runner.Command("kubectl", "config", "set-context", "--current", "--namespace="+targetNamespace)
 		cmdExec.Stdout = os.Stdout
 		cmdExec.Stderr = os.Stderr
 
 		if err := cmdExec.Run(); err != nil {
-			return fmt.Errorf("failed to switch namespace: %w", err)
+			return i18n.Errorf("failed to switch namespace: %w", err)
 		}
 
-		color.Green("Switched to namespace: %s", targetNamespace)
+		color.Green(i18n.T("Switched to namespace: %s"), targetNamespace)
 		return nil
 	},
 }
 
 var klogsCmd = &cobra.Command{
-	Use:   "klogs [pod]",
-	Short: "Get pod logs with fuzzy finder",
+	Use:               "klogs [pod]",
+	Short:             "Get pod logs with fuzzy finder",
+	ValidArgsFunction: firstArgOnly(completePods),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		var targetPod string
 
@@ -139,14 +151,17 @@ var klogsCmd = &cobra.Command{
 			targetPod = args[0]
 		} else {
 			// Use fuzzy finder to select pod
+			if err := prompt.RequireInteractive(i18n.T("pod")); err != nil {
+				return err
+			}
 			pods, err := kubernetes.GetPods()
 			if err != nil {
-				return fmt.Errorf("failed to get pods: %w", err)
+				return i18n.Errorf("failed to get pods: %w", err)
 			}
 
 			selected, err := kubernetes.SelectPod(pods)
 			if err != nil {
-				return fmt.Errorf("failed to select pod: %w", err)
+				return i18n.Errorf("failed to select pod: %w", err)
 			}
 			targetPod = selected
 		}
@@ -163,7 +178,7 @@ var klogsCmd = &cobra.Command{
 			if tail > 0 {
 				cmdStr += fmt.Sprintf(" --tail=%d", tail)
 			}
-			color.Yellow("Would run: %s", cmdStr)
+			color.Yellow(i18n.T("Would run: %s"), cmdStr)
 			return nil
 		}
 
@@ -176,13 +191,14 @@ var klogsCmd = &cobra.Command{
 			kubectlArgs = append(kubectlArgs, fmt.Sprintf("--tail=%d", tail))
 		}
 
-		cmdExec := exec.Command("kubectl", kubectlArgs...)
+		cmdExec := runner.Command("kubectl", kubectlArgs...)
 		cmdExec.Stdout = os.Stdout
 		cmdExec.Stderr = os.Stderr
 		cmdExec.Stdin = os.Stdin
+		cmdExec.LongRunning = follow
 
 		if err := cmdExec.Run(); err != nil {
-			return fmt.Errorf("failed to get logs: %w", err)
+			return i18n.Errorf("failed to get logs: %w", err)
 		}
 
 		return nil
@@ -195,7 +211,7 @@ var kpodsCmd = &cobra.Command{
 	RunE: func(cmd *cobra.Command, args []string) error {
 		pods, err := kubernetes.GetPods()
 		if err != nil {
-			return fmt.Errorf("failed to get pods: %w", err)
+			return i18n.Errorf("failed to get pods: %w", err)
 		}
 
 		kubernetes.DisplayPods(pods)
@@ -208,16 +224,16 @@ var ksvcCmd = &cobra.Command{
 	Short: "List services",
 	RunE: func(cmd *cobra.Command, args []string) error {
 		if dryRun {
-			color.Yellow("Would run: kubectl get services")
+			color.Yellow(i18n.T("Would run: kubectl get services"))
 			return nil
 		}
 
-		cmdExec := exec.Command("kubectl", "get", "services")
+		cmdExec := runner.Command("kubectl", "get", "services")
 		cmdExec.Stdout = os.Stdout
 		cmdExec.Stderr = os.Stderr
 
 		if err := cmdExec.Run(); err != nil {
-			return fmt.Errorf("failed to get services: %w", err)
+			return i18n.Errorf("failed to get services: %w", err)
 		}
 
 		return nil
@@ -229,16 +245,16 @@ var kingressCmd = &cobra.Command{
 	Short: "List ingress resources",
 	RunE: func(cmd *cobra.Command, args []string) error {
 		if dryRun {
-			color.Yellow("Would run: kubectl get ingress")
+			color.Yellow(i18n.T("Would run: kubectl get ingress"))
 			return nil
 		}
 
-		cmdExec := exec.Command("kubectl", "get", "ingress")
+		cmdExec := runner.Command("kubectl", "get", "ingress")
 		cmdExec.Stdout = os.Stdout
 		cmdExec.Stderr = os.Stderr
 
 		if err := cmdExec.Run(); err != nil {
-			return fmt.Errorf("failed to get ingress: %w", err)
+			return i18n.Errorf("failed to get ingress: %w", err)
 		}
 
 		return nil
@@ -246,8 +262,9 @@ var kingressCmd = &cobra.Command{
 }
 
 var kexecCmd = &cobra.Command{
-	Use:   "kexec [pod] [command]",
-	Short: "Execute command in pod with fuzzy finder",
+	Use:               "kexec [pod] [command]",
+	Short:             "Execute command in pod with fuzzy finder",
+	ValidArgsFunction: firstArgOnly(completePods),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		var targetPod string
 		var command string
@@ -256,14 +273,17 @@ var kexecCmd = &cobra.Command{
 			targetPod = args[0]
 		} else {
 			// Use fuzzy finder to select pod
+			if err := prompt.RequireInteractive(i18n.T("pod")); err != nil {
+				return err
+			}
 			pods, err := kubernetes.GetPods()
 			if err != nil {
-				return fmt.Errorf("failed to get pods: %w", err)
+				return i18n.Errorf("failed to get pods: %w", err)
 			}
 
 			selected, err := kubernetes.SelectPod(pods)
 			if err != nil {
-				return fmt.Errorf("failed to select pod: %w", err)
+				return i18n.Errorf("failed to select pod: %w", err)
 			}
 			targetPod = selected
 		}
@@ -275,7 +295,7 @@ var kexecCmd = &cobra.Command{
 		}
 
 		if dryRun {
-			color.Yellow("Would run: kubectl exec -it %s -- %s", targetPod, command)
+			color.Yellow(i18n.T("Would run: kubectl exec -it %s -- %s"), targetPod, command)
 			return nil
 		}
 
@@ -283,13 +303,14 @@ var kexecCmd = &cobra.Command{
 		kubectlArgs := []string{"exec", "-it", targetPod, "--"}
 		kubectlArgs = append(kubectlArgs, strings.Split(command, " ")...)
 
-		cmdExec := exec.Command("kubectl", kubectlArgs...)
+		cmdExec := runner.Command("kubectl", kubectlArgs...)
 		cmdExec.Stdout = os.Stdout
 		cmdExec.Stderr = os.Stderr
 		cmdExec.Stdin = os.Stdin
+		cmdExec.LongRunning = true
 
 		if err := cmdExec.Run(); err != nil {
-			return fmt.Errorf("failed to execute command: %w", err)
+			return i18n.Errorf("failed to execute command: %w", err)
 		}
 
 		return nil
@@ -297,8 +318,9 @@ var kexecCmd = &cobra.Command{
 }
 
 var khpaCmd = &cobra.Command{
-	Use:   "khpa [action] [name] [value]",
-	Short: "Manage HPA (Horizontal Pod Autoscaler)",
+	Use:       "khpa <action> [name] [value]",
+	Short:     "Manage HPA (Horizontal Pod Autoscaler)",
+	ValidArgs: []string{"list", "get", "set-min", "set-max", "set-target"},
 	Long: `Manage HPA with common operations:
 
   opsbrew k8s khpa list                    - List all HPAs
@@ -311,11 +333,8 @@ Examples:
   opsbrew k8s khpa list -n production
   opsbrew k8s khpa set-min my-hpa 2 -n production
   opsbrew k8s khpa set-max my-hpa 10 --namespace=production`,
+	Args: requireArgs(1, "action is required (list, get, set-min, set-max, set-target)"),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		if len(args) == 0 {
-			return fmt.Errorf("action is required (list, get, set-min, set-max, set-target)")
-		}
-
 		action := args[0]
 		namespace, _ := cmd.Flags().GetString("namespace")
 
@@ -324,33 +343,34 @@ Examples:
 			return runHpaList(namespace)
 		case "get":
 			if len(args) < 2 {
-				return fmt.Errorf("HPA name is required")
+				return exitcode.Errorf(exitcode.Validation, "HPA name is required")
 			}
 			return runHpaGet(args[1], namespace)
 		case "set-min":
 			if len(args) < 3 {
-				return fmt.Errorf("HPA name and value are required")
+				return exitcode.Errorf(exitcode.Validation, "HPA name and value are required")
 			}
 			return runHpaSetMin(args[1], args[2], namespace)
 		case "set-max":
 			if len(args) < 3 {
-				return fmt.Errorf("HPA name and value are required")
+				return exitcode.Errorf(exitcode.Validation, "HPA name and value are required")
 			}
 			return runHpaSetMax(args[1], args[2], namespace)
 		case "set-target":
 			if len(args) < 3 {
-				return fmt.Errorf("HPA name and value are required")
+				return exitcode.Errorf(exitcode.Validation, "HPA name and value are required")
 			}
 			return runHpaSetTarget(args[1], args[2], namespace)
 		default:
-			return fmt.Errorf("unknown action: %s", action)
+			return exitcode.Errorf(exitcode.Validation, "unknown action: %s", action)
 		}
 	},
 }
 
 var kscaleCmd = &cobra.Command{
-	Use:   "kscale [type] [name] [replicas]",
-	Short: "Scale deployment/replicaset/statefulset",
+	Use:       "kscale <type> <name> <replicas>",
+	Short:     "Scale deployment/replicaset/statefulset",
+	ValidArgs: []string{"deployment", "replicaset", "statefulset"},
 	Long: `Scale Kubernetes resources:
 
   opsbrew k8s kscale deployment [name] [replicas]  - Scale deployment
@@ -360,11 +380,8 @@ var kscaleCmd = &cobra.Command{
 Examples:
   opsbrew k8s kscale deployment my-app 5 -n production
   opsbrew k8s kscale statefulset my-db 3 --namespace=production`,
+	Args: requireArgs(3, "resource type, name, and replicas are required"),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		if len(args) < 3 {
-			return fmt.Errorf("resource type, name, and replicas are required")
-		}
-
 		resourceType := args[0]
 		name := args[1]
 		replicas := args[2]
@@ -372,9 +389,9 @@ Examples:
 
 		if dryRun {
 			if namespace != "" {
-				color.Yellow("Would run: kubectl scale %s %s --replicas=%s -n %s", resourceType, name, replicas, namespace)
+				color.Yellow(i18n.T("Would run: kubectl scale %s %s --replicas=%s -n %s"), resourceType, name, replicas, namespace)
 			} else {
-				color.Yellow("Would run: kubectl scale %s %s --replicas=%s", resourceType, name, replicas)
+				color.Yellow(i18n.T("Would run: kubectl scale %s %s --replicas=%s"), resourceType, name, replicas)
 			}
 			return nil
 		}
@@ -384,15 +401,15 @@ Examples:
 			args = append(args, "-n", namespace)
 		}
 
-		cmdExec := exec.Command("kubectl", args...)
+		cmdExec := runner.Command("kubectl", args...)
 		cmdExec.Stdout = os.Stdout
 		cmdExec.Stderr = os.Stderr
 
 		if err := cmdExec.Run(); err != nil {
-			return fmt.Errorf("failed to scale %s %s: %w", resourceType, name, err)
+			return i18n.Errorf("failed to scale %s %s: %w", resourceType, name, err)
 		}
 
-		color.Green("Scaled %s %s to %s replicas", resourceType, name, replicas)
+		color.Green(i18n.T("Scaled %s %s to %s replicas"), resourceType, name, replicas)
 		return nil
 	},
 }
@@ -415,18 +432,20 @@ func init() {
 
 	// Add flags for khpa
 	khpaCmd.Flags().StringP("namespace", "n", "", "Namespace (defaults to current namespace)")
+	khpaCmd.RegisterFlagCompletionFunc("namespace", completeNamespaceFlag)
 
 	// Add flags for kscale
 	kscaleCmd.Flags().StringP("namespace", "n", "", "Namespace (defaults to current namespace)")
+	kscaleCmd.RegisterFlagCompletionFunc("namespace", completeNamespaceFlag)
 }
 
 // HPA helper functions
 func runHpaList(namespace string) error {
 	if dryRun {
 		if namespace != "" {
-			color.Yellow("Would run: kubectl get hpa -n %s", namespace)
+			color.Yellow(i18n.T("Would run: kubectl get hpa -n %s"), namespace)
 		} else {
-			color.Yellow("Would run: kubectl get hpa")
+			color.Yellow(i18n.T("Would run: kubectl get hpa"))
 		}
 		return nil
 	}
@@ -436,12 +455,12 @@ func runHpaList(namespace string) error {
 		args = append(args, "-n", namespace)
 	}
 
-	cmdExec := exec.Command("kubectl", args...)
+	cmdExec := runner.Command("kubectl", args...)
 	cmdExec.Stdout = os.Stdout
 	cmdExec.Stderr = os.Stderr
 
 	if err := cmdExec.Run(); err != nil {
-		return fmt.Errorf("failed to list HPAs: %w", err)
+		return i18n.Errorf("failed to list HPAs: %w", err)
 	}
 
 	return nil
@@ -450,9 +469,9 @@ func runHpaList(namespace string) error {
 func runHpaGet(name, namespace string) error {
 	if dryRun {
 		if namespace != "" {
-			color.Yellow("Would run: kubectl get hpa %s -o yaml -n %s", name, namespace)
+			color.Yellow(i18n.T("Would run: kubectl get hpa %s -o yaml -n %s"), name, namespace)
 		} else {
-			color.Yellow("Would run: kubectl get hpa %s -o yaml", name)
+			color.Yellow(i18n.T("Would run: kubectl get hpa %s -o yaml"), name)
 		}
 		return nil
 	}
@@ -462,12 +481,12 @@ func runHpaGet(name, namespace string) error {
 		args = append(args, "-n", namespace)
 	}
 
-	cmdExec := exec.Command("kubectl", args...)
+	cmdExec := runner.Command("kubectl", args...)
 	cmdExec.Stdout = os.Stdout
 	cmdExec.Stderr = os.Stderr
 
 	if err := cmdExec.Run(); err != nil {
-		return fmt.Errorf("failed to get HPA %s: %w", name, err)
+		return i18n.Errorf("failed to get HPA %s: %w", name, err)
 	}
 
 	return nil
@@ -476,9 +495,9 @@ func runHpaGet(name, namespace string) error {
 func runHpaSetMin(name, value, namespace string) error {
 	if dryRun {
 		if namespace != "" {
-			color.Yellow("Would run: kubectl patch hpa %s -p '{\"spec\":{\"minReplicas\":%s}}' -n %s", name, value, namespace)
+			color.Yellow(i18n.T("Would run: kubectl patch hpa %s -p '{\"spec\":{\"minReplicas\":%s}}' -n %s"), name, value, namespace)
 		} else {
-			color.Yellow("Would run: kubectl patch hpa %s -p '{\"spec\":{\"minReplicas\":%s}}'", name, value)
+			color.Yellow(i18n.T("Would run: kubectl patch hpa %s -p '{\"spec\":{\"minReplicas\":%s}}'"), name, value)
 		}
 		return nil
 	}
@@ -489,24 +508,24 @@ func runHpaSetMin(name, value, namespace string) error {
 		args = append(args, "-n", namespace)
 	}
 
-	cmdExec := exec.Command("kubectl", args...)
+	cmdExec := runner.Command("kubectl", args...)
 	cmdExec.Stdout = os.Stdout
 	cmdExec.Stderr = os.Stderr
 
 	if err := cmdExec.Run(); err != nil {
-		return fmt.Errorf("failed to set min replicas for HPA %s: %w", name, err)
+		return i18n.Errorf("failed to set min replicas for HPA %s: %w", name, err)
 	}
 
-	color.Green("Set min replicas to %s for HPA %s", value, name)
+	color.Green(i18n.T("Set min replicas to %s for HPA %s"), value, name)
 	return nil
 }
 
 func runHpaSetMax(name, value, namespace string) error {
 	if dryRun {
 		if namespace != "" {
-			color.Yellow("Would run: kubectl patch hpa %s -p '{\"spec\":{\"maxReplicas\":%s}}' -n %s", name, value, namespace)
+			color.Yellow(i18n.T("Would run: kubectl patch hpa %s -p '{\"spec\":{\"maxReplicas\":%s}}' -n %s"), name, value, namespace)
 		} else {
-			color.Yellow("Would run: kubectl patch hpa %s -p '{\"spec\":{\"maxReplicas\":%s}}'", name, value)
+			color.Yellow(i18n.T("Would run: kubectl patch hpa %s -p '{\"spec\":{\"maxReplicas\":%s}}'"), name, value)
 		}
 		return nil
 	}
@@ -517,24 +536,24 @@ func runHpaSetMax(name, value, namespace string) error {
 		args = append(args, "-n", namespace)
 	}
 
-	cmdExec := exec.Command("kubectl", args...)
+	cmdExec := runner.Command("kubectl", args...)
 	cmdExec.Stdout = os.Stdout
 	cmdExec.Stderr = os.Stderr
 
 	if err := cmdExec.Run(); err != nil {
-		return fmt.Errorf("failed to set max replicas for HPA %s: %w", name, err)
+		return i18n.Errorf("failed to set max replicas for HPA %s: %w", name, err)
 	}
 
-	color.Green("Set max replicas to %s for HPA %s", value, name)
+	color.Green(i18n.T("Set max replicas to %s for HPA %s"), value, name)
 	return nil
 }
 
 func runHpaSetTarget(name, value, namespace string) error {
 	if dryRun {
 		if namespace != "" {
-			color.Yellow("Would run: kubectl patch hpa %s -p '{\"spec\":{\"metrics\":[{\"resource\":{\"name\":\"cpu\",\"target\":{\"type\":\"Utilization\",\"averageUtilization\":%s}}}]}}' -n %s", name, value, namespace)
+			color.Yellow(i18n.T("Would run: kubectl patch hpa %s -p '{\"spec\":{\"metrics\":[{\"resource\":{\"name\":\"cpu\",\"target\":{\"type\":\"Utilization\",\"averageUtilization\":%s}}}]}}' -n %s"), name, value, namespace)
 		} else {
-			color.Yellow("Would run: kubectl patch hpa %s -p '{\"spec\":{\"metrics\":[{\"resource\":{\"name\":\"cpu\",\"target\":{\"type\":\"Utilization\",\"averageUtilization\":%s}}}]}}'", name, value)
+			color.Yellow(i18n.T("Would run: kubectl patch hpa %s -p '{\"spec\":{\"metrics\":[{\"resource\":{\"name\":\"cpu\",\"target\":{\"type\":\"Utilization\",\"averageUtilization\":%s}}}]}}'"), name, value)
 		}
 		return nil
 	}
@@ -545,14 +564,14 @@ func runHpaSetTarget(name, value, namespace string) error {
 		args = append(args, "-n", namespace)
 	}
 
-	cmdExec := exec.Command("kubectl", args...)
+	cmdExec := runner.Command("kubectl", args...)
 	cmdExec.Stdout = os.Stdout
 	cmdExec.Stderr = os.Stderr
 
 	if err := cmdExec.Run(); err != nil {
-		return fmt.Errorf("failed to set target CPU for HPA %s: %w", name, err)
+		return i18n.Errorf("failed to set target CPU for HPA %s: %w", name, err)
 	}
 
-	color.Green("Set target CPU to %s%% for HPA %s", value, name)
+	color.Green(i18n.T("Set target CPU to %s%% for HPA %s"), value, name)
 	return nil
 }
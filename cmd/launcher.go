@@ -0,0 +1,134 @@
+package cmd
+
+import (
+	"os"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/mattn/go-isatty"
+	"github.com/nghiadaulau/opsbrew/internal/config"
+	"github.com/nghiadaulau/opsbrew/internal/i18n"
+	"github.com/nghiadaulau/opsbrew/internal/launcher"
+	"github.com/nghiadaulau/opsbrew/internal/prompt"
+	"github.com/spf13/cobra"
+)
+
+// launched is the command picked in the launcher, once it has been run
+var launched *cobra.Command
+
+// runLauncher opens a fuzzy menu of commands and recipes when opsbrew is run bare
+func runLauncher(cmd *cobra.Command, args []string) error {
+	// The fuzzy finder needs a terminal and prompts, so fall back to help otherwise
+	if prompt.NonInteractive || !isatty.IsTerminal(os.Stdin.Fd()) && !isatty.IsCygwinTerminal(os.Stdin.Fd()) {
+		return cmd.Help()
+	}
+
+	cfg, err := config.GetRepoConfig()
+	if err != nil {
+		return i18n.Errorf("failed to load config: %w", err)
+	}
+
+	entries := launcherEntries(cmd.Root(), cfg)
+
+	usage, err := launcher.LoadUsage()
+	if err != nil && verbose {
+		color.Yellow(i18n.T("Ignoring usage history: %v"), err)
+	}
+	launcher.SortByUsage(entries, usage)
+
+	selected, err := launcher.Select(entries, usage)
+	if err != nil {
+		return i18n.Errorf("failed to select command: %w", err)
+	}
+
+	if err := launcher.RecordUsage(selected.Name); err != nil && verbose {
+		color.Yellow(i18n.T("Failed to record usage: %v"), err)
+	}
+
+	target, targetArgs, err := cmd.Root().Find(selected.Args)
+	if err != nil {
+		return i18n.Errorf("failed to find command %s: %w", selected.Name, err)
+	}
+
+	// Ask for required arguments the entry does not already carry
+	if required := requiredArgs(target); len(targetArgs) < required {
+		input, err := prompt.Input(i18n.Sprintf("Arguments for %s %s", target.Parent().CommandPath(), target.Use), "", func(value string) error {
+			return target.ValidateArgs(append(targetArgs, strings.Fields(value)...))
+		})
+		if err != nil {
+			return err
+		}
+		targetArgs = append(targetArgs, strings.Fields(input)...)
+	}
+
+	if err := target.ParseFlags(targetArgs); err != nil {
+		return target.FlagErrorFunc()(target, err)
+	}
+	targetArgs = target.Flags().Args()
+	if err := target.ValidateArgs(targetArgs); err != nil {
+		return err
+	}
+
+	// Flags typed with the arguments may change settings applied at startup
+	applySettings()
+
+	if verbose {
+		color.Cyan(i18n.T("Running: %s"), strings.TrimSpace(target.CommandPath()+" "+strings.Join(targetArgs, " ")))
+	}
+
+	// Execute reports telemetry and errors against the picked command
+	launched = target
+	if target.RunE != nil {
+		return target.RunE(target, targetArgs)
+	}
+	target.Run(target, targetArgs)
+	return nil
+}
+
+// maxLauncherArgs bounds the search for the number of arguments a command requires
+const maxLauncherArgs = 8
+
+// requiredArgs returns how many arguments the command's Args validator requires
+func requiredArgs(cmd *cobra.Command) int {
+	for n := 0; n < maxLauncherArgs; n++ {
+		if cmd.ValidateArgs(make([]string, n)) == nil {
+			return n
+		}
+	}
+	return 0
+}
+
+// launcherEntries collects runnable subcommands and saved recipes
+func launcherEntries(root *cobra.Command, cfg *config.Config) []launcher.Entry {
+	var entries []launcher.Entry
+
+	var walk func(c *cobra.Command)
+	walk = func(c *cobra.Command) {
+		for _, sub := range c.Commands() {
+			if sub.Hidden || sub.Name() == "help" || sub.Name() == "completion" {
+				continue
+			}
+			if sub.Runnable() {
+				path := strings.Fields(strings.TrimPrefix(sub.CommandPath(), root.Name()))
+				entries = append(entries, launcher.Entry{
+					Name:        strings.Join(path, " "),
+					Description: sub.Short,
+					Args:        path,
+				})
+			}
+			walk(sub)
+		}
+	}
+	walk(root)
+
+	for name, recipe := range cfg.Brew.Recipes {
+		entries = append(entries, launcher.Entry{
+			Name:        "brew run " + name,
+			Description: recipe.Description,
+			Args:        []string{"brew", "run", name},
+			Recipe:      true,
+		})
+	}
+
+	return entries
+}
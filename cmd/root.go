@@ -1,21 +1,30 @@
 package cmd
 
 import (
+	"errors"
 	"fmt"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/fatih/color"
 	"github.com/mitchellh/go-homedir"
 	"github.com/nghiadaulau/opsbrew/internal/config"
+	"github.com/nghiadaulau/opsbrew/internal/exitcode"
+	"github.com/nghiadaulau/opsbrew/internal/i18n"
+	"github.com/nghiadaulau/opsbrew/internal/prompt"
+	"github.com/nghiadaulau/opsbrew/internal/runner"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
 
 var (
-	cfgFile string
-	verbose bool
-	dryRun  bool
-	confirm bool
+	cfgFile        string
+	verbose        bool
+	dryRun         bool
+	confirm        bool
+	nonInteractive bool
+	timeout        time.Duration
 )
 
 // rootCmd represents the base command when called without any subcommands
@@ -30,7 +39,11 @@ It provides shortcuts for common Git and kubectl operations, with features like:
 - Safe defaults with dry-run and confirmation modes
 - Project template initialization
 
+Run opsbrew without arguments to pick a command or recipe from an
+interactive launcher.
+
 Examples:
+  opsbrew
   opsbrew git status
   opsbrew git sync
   opsbrew kctx
@@ -39,11 +52,44 @@ Examples:
   opsbrew init go-service
   opsbrew brew save my-workflow`,
 	Version: "0.1.0",
+	Args:    unknownCommand,
+	RunE:    runLauncher,
+	// Errors and usage are printed by Execute so output can follow the error kind
+	SilenceErrors: true,
+	SilenceUsage:  true,
 }
 
 // Execute adds all child commands to the root command and sets flags appropriately.
 func Execute() error {
-	return rootCmd.Execute()
+	tagArgErrors(rootCmd)
+
+	executed, err := rootCmd.ExecuteC()
+
+	// Report the command picked in the launcher rather than the launcher itself
+	if launched != nil {
+		executed = launched
+	}
+
+	recordTelemetry(executed)
+
+	if err != nil {
+		reportError(executed, err)
+	}
+
+	return err
+}
+
+// reportError prints err, followed by usage when the command line itself was wrong
+func reportError(executed *cobra.Command, err error) {
+	if exitcode.KindOf(err) == exitcode.Cancelled {
+		fmt.Fprintln(os.Stderr, i18n.T("Operation cancelled"))
+		return
+	}
+
+	fmt.Fprintf(os.Stderr, i18n.T("Error: %v\n"), err)
+	if executed != nil && exitcode.KindOf(err) == exitcode.Validation {
+		executed.PrintErrln(executed.UsageString())
+	}
 }
 
 func init() {
@@ -54,11 +100,57 @@ func init() {
 	rootCmd.PersistentFlags().BoolVarP(&verbose, "verbose", "v", false, "verbose output")
 	rootCmd.PersistentFlags().BoolVar(&dryRun, "dry-run", false, "show what would be done without executing")
 	rootCmd.PersistentFlags().BoolVar(&confirm, "confirm", false, "skip confirmation prompts")
+	rootCmd.PersistentFlags().BoolVar(&nonInteractive, "non-interactive", false, "never prompt; use defaults and decline confirmations unless --confirm is set")
+	rootCmd.PersistentFlags().DurationVar(&timeout, "timeout", 0, "kill external commands running longer than this, e.g. 30s (default from config)")
+
+	// Flag parsing errors are usage mistakes
+	rootCmd.SetFlagErrorFunc(func(cmd *cobra.Command, err error) error {
+		return exitcode.Wrap(exitcode.Validation, err)
+	})
 
 	// Local flags
 	rootCmd.Flags().BoolP("toggle", "t", false, "Help message for toggle")
 }
 
+// unknownCommand rejects arguments to the root command, which only names subcommands
+func unknownCommand(cmd *cobra.Command, args []string) error {
+	if len(args) == 0 {
+		return nil
+	}
+
+	err := i18n.Errorf("unknown command %q for %q", args[0], cmd.CommandPath())
+	if cmd.SuggestionsMinimumDistance <= 0 {
+		cmd.SuggestionsMinimumDistance = 2
+	}
+	if suggestions := cmd.SuggestionsFor(args[0]); len(suggestions) > 0 {
+		err = fmt.Errorf("%w\n\n%s\n\t%s", err, i18n.T("Did you mean this?"), strings.Join(suggestions, "\n\t"))
+	}
+	return exitcode.Wrap(exitcode.Validation, err)
+}
+
+// requireArgs returns an argument validator that fails with message when fewer than n arguments are given
+func requireArgs(n int, message string) cobra.PositionalArgs {
+	return func(cmd *cobra.Command, args []string) error {
+		if len(args) < n {
+			return exitcode.Wrap(exitcode.Validation, errors.New(i18n.T(message)))
+		}
+		return nil
+	}
+}
+
+// tagArgErrors tags errors from cobra argument validators as validation errors
+func tagArgErrors(cmd *cobra.Command) {
+	if validate := cmd.Args; validate != nil {
+		cmd.Args = func(cmd *cobra.Command, args []string) error {
+			return exitcode.Wrap(exitcode.Validation, validate(cmd, args))
+		}
+	}
+
+	for _, sub := range cmd.Commands() {
+		tagArgErrors(sub)
+	}
+}
+
 // initConfig reads in config file and ENV variables if set.
 func initConfig() {
 	if cfgFile != "" {
@@ -69,7 +161,7 @@ func initConfig() {
 		home, err := homedir.Dir()
 		if err != nil {
 			fmt.Println(err)
-			os.Exit(1)
+			os.Exit(exitcode.CodeConfig)
 		}
 
 		// Search config in home directory with name ".opsbrew" (without extension).
@@ -81,17 +173,114 @@ func initConfig() {
 
 	// If a config file is found, read it in.
 	if err := viper.ReadInConfig(); err == nil {
-		if verbose {
-			color.Green("Using config file: %s", viper.ConfigFileUsed())
+		if verbose && !completing() {
+			color.Green(i18n.T("Using config file: %s"), viper.ConfigFileUsed())
 		}
 	} else {
-		// Create default config if it doesn't exist
+		// Create default config if it doesn't exist, but never while completing:
+		// anything printed would be offered to the shell as a candidate
 		if _, ok := err.(viper.ConfigFileNotFoundError); ok {
-			if err := config.CreateDefaultConfig(); err != nil {
-				color.Red("Error creating default config: %v", err)
-			} else {
-				color.Green("Created default config file: %s", viper.ConfigFileUsed())
+			if !completing() {
+				if err := config.CreateDefaultConfig(); err != nil {
+					color.Red(i18n.T("Error creating default config: %v"), err)
+				} else {
+					color.Green(i18n.T("Created default config file: %s"), viper.ConfigFileUsed())
+				}
 			}
+		} else if !completing() {
+			// A config file that exists but cannot be read or parsed is fatal;
+			// the locale comes from the environment since the config is unusable
+			i18n.SetLocale(i18n.Detect(""))
+			fmt.Fprintf(os.Stderr, i18n.T("Error: failed to read config file: %v\n"), err)
+			os.Exit(exitcode.CodeConfig)
+		}
+	}
+
+	// Telemetry is a global opt-in, read before any repository config is loaded
+	captureTelemetrySettings()
+
+	applySettings()
+}
+
+// applySettings applies flags and config values to the packages that use them
+func applySettings() {
+	// Select the message language from config or the environment
+	i18n.SetLocale(i18n.Detect(viper.GetString("ui.locale")))
+
+	// Answer prompts from flags and config where possible
+	prompt.AssumeYes = confirm || viper.GetBool("ui.confirm")
+	prompt.NonInteractive = nonInteractive || viper.GetBool("ui.non_interactive")
+
+	// Echo every external command when verbose output is requested
+	runner.Verbose = verbose || viper.GetBool("ui.verbose")
+
+	configureTimeouts()
+
+	// Completion runs on every TAB, so lookups must give up quickly on an unreachable cluster
+	if completing() {
+		runner.Timeout = completionTimeout
+	}
+}
+
+// completionTimeout bounds each external command run while completing
+const completionTimeout = 2 * time.Second
+
+// completing reports whether opsbrew was invoked by the shell to complete a command line
+func completing() bool {
+	return len(os.Args) > 1 && (os.Args[1] == cobra.ShellCompRequestCmd || os.Args[1] == cobra.ShellCompNoDescRequestCmd)
+}
+
+// configureTimeouts applies the --timeout flag and per-tool timeouts from the global
+// config, then from a repository .opsbrew.yaml, whose values take precedence.
+func configureTimeouts() {
+	runner.Timeout = timeout
+
+	applyTimeouts(viper.GetViper())
+
+	if _, err := os.Stat(".opsbrew.yaml"); err == nil {
+		repo := viper.New()
+		repo.SetConfigFile(".opsbrew.yaml")
+		if err := repo.ReadInConfig(); err == nil {
+			applyTimeouts(repo)
 		}
 	}
 }
+
+// applyTimeouts sets the default and per-tool timeouts found in v
+func applyTimeouts(v *viper.Viper) {
+	if value := v.GetString("timeouts.default"); value != "" {
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			color.Red(i18n.T("Invalid timeouts.default %q: %v"), value, err)
+		} else {
+			runner.DefaultTimeout = d
+		}
+	}
+
+	for tool, value := range v.GetStringMapString("timeouts.tools") {
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			color.Red(i18n.T("Invalid timeout for %s %q: %v"), tool, value, err)
+			continue
+		}
+		runner.ToolTimeouts[tool] = d
+	}
+}
+
+// confirmAction asks the user to confirm an action, returning exitcode.ErrCancelled when declined.
+// A repository config with ui.confirm set also skips the prompt.
+func confirmAction(cfg *config.Config, message string) error {
+	if cfg.UI.Confirm {
+		return nil
+	}
+
+	ok, err := prompt.Confirm(message, false)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return exitcode.ErrCancelled
+	}
+
+	return nil
+}
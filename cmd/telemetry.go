@@ -0,0 +1,175 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/nghiadaulau/opsbrew/internal/config"
+	"github.com/nghiadaulau/opsbrew/internal/i18n"
+	"github.com/nghiadaulau/opsbrew/internal/telemetry"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var telemetryCmd = &cobra.Command{
+	Use:   "telemetry",
+	Short: "Manage opt-in anonymous usage metrics",
+	Long: `Telemetry records which opsbrew subcommands are used so maintainers know
+where to invest. It is off by default. Only the subcommand path (for example
+"k8s kctx"), the opsbrew version, OS, and architecture are recorded; arguments,
+names, and identifiers are never collected.
+
+Events are queued in ~/.opsbrew/telemetry.yaml and sent in batches to
+telemetry.endpoint once telemetry.batch_size events have accumulated.
+
+Available commands:
+  on      - Enable usage metrics
+  off     - Disable usage metrics and discard queued events
+  status  - Show telemetry settings and queued events`,
+}
+
+var telemetryOnCmd = &cobra.Command{
+	Use:   "on",
+	Short: "Enable usage metrics",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return i18n.Errorf("failed to load config: %w", err)
+		}
+
+		if dryRun {
+			color.Yellow(i18n.T("Would enable telemetry"))
+			return nil
+		}
+
+		cfg.Telemetry.Enabled = true
+		if err := config.SaveConfig(cfg); err != nil {
+			return i18n.Errorf("failed to enable telemetry: %w", err)
+		}
+
+		color.Green(i18n.T("Telemetry enabled"))
+		if cfg.Telemetry.Endpoint == "" {
+			color.Yellow(i18n.T("No telemetry.endpoint configured; events are queued locally"))
+		}
+		return nil
+	},
+}
+
+var telemetryOffCmd = &cobra.Command{
+	Use:   "off",
+	Short: "Disable usage metrics and discard queued events",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return i18n.Errorf("failed to load config: %w", err)
+		}
+
+		if dryRun {
+			color.Yellow(i18n.T("Would disable telemetry and discard queued events"))
+			return nil
+		}
+
+		cfg.Telemetry.Enabled = false
+		if err := config.SaveConfig(cfg); err != nil {
+			return i18n.Errorf("failed to disable telemetry: %w", err)
+		}
+
+		if err := telemetry.Clear(); err != nil {
+			return err
+		}
+
+		color.Green(i18n.T("Telemetry disabled"))
+		return nil
+	},
+}
+
+var telemetryStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Show telemetry settings and queued events",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return i18n.Errorf("failed to load config: %w", err)
+		}
+
+		events, err := telemetry.Pending()
+		if err != nil {
+			return err
+		}
+
+		fmt.Println(i18n.T("=== Telemetry ==="))
+		if cfg.Telemetry.Enabled {
+			color.Green(i18n.T("  Enabled: yes"))
+		} else {
+			color.Yellow(i18n.T("  Enabled: no"))
+		}
+		endpoint := cfg.Telemetry.Endpoint
+		if endpoint == "" {
+			endpoint = i18n.T("(not configured)")
+		}
+		fmt.Printf(i18n.T("  Endpoint: %s\n"), endpoint)
+		batchSize := cfg.Telemetry.BatchSize
+		if batchSize <= 0 {
+			batchSize = telemetry.DefaultBatchSize
+		}
+		fmt.Printf(i18n.T("  Batch size: %d\n"), batchSize)
+		fmt.Printf(i18n.T("  Queued events: %d\n"), len(events))
+
+		return nil
+	},
+}
+
+// telemetrySettings is the opt-in read from the global config before the command runs;
+// commands that load a repository config repoint viper at it afterwards
+var telemetrySettings struct {
+	Enabled   bool
+	Endpoint  string
+	BatchSize int
+}
+
+// captureTelemetrySettings records the telemetry opt-in from the config viper has just read
+func captureTelemetrySettings() {
+	telemetrySettings.Enabled = viper.GetBool("telemetry.enabled")
+	telemetrySettings.Endpoint = viper.GetString("telemetry.endpoint")
+	telemetrySettings.BatchSize = viper.GetInt("telemetry.batch_size")
+}
+
+// recordTelemetry queues a usage event for the executed command when telemetry is enabled
+func recordTelemetry(executed *cobra.Command) {
+	if executed == nil || executed.Hidden || strings.HasPrefix(executed.Name(), "__") {
+		return
+	}
+
+	// Toggling telemetry is not itself recorded
+	if executed == telemetryCmd || executed.Parent() == telemetryCmd {
+		return
+	}
+
+	if !telemetrySettings.Enabled {
+		return
+	}
+
+	command := strings.TrimSpace(strings.TrimPrefix(executed.CommandPath(), executed.Root().Name()))
+	if command == "" {
+		command = executed.Root().Name()
+	}
+
+	// Telemetry must never get in the way of the command itself
+	if err := telemetry.Record(command, executed.Root().Version); err != nil {
+		if verbose {
+			color.Yellow(i18n.T("Failed to record telemetry: %v"), err)
+		}
+		return
+	}
+	if err := telemetry.Flush(telemetrySettings.Endpoint, telemetrySettings.BatchSize); err != nil && verbose {
+		color.Yellow(i18n.T("Failed to send telemetry: %v"), err)
+	}
+}
+
+func init() {
+	rootCmd.AddCommand(telemetryCmd)
+	telemetryCmd.AddCommand(telemetryOnCmd)
+	telemetryCmd.AddCommand(telemetryOffCmd)
+	telemetryCmd.AddCommand(telemetryStatusCmd)
+}
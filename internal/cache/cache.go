@@ -0,0 +1,98 @@
+package cache
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"time"
+
+	"github.com/mitchellh/go-homedir"
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultTTL is how long cached values stay fresh
+const DefaultTTL = 30 * time.Second
+
+// Entry represents a cached list of values
+type Entry struct {
+	Values    []string  `yaml:"values"`
+	UpdatedAt time.Time `yaml:"updated_at"`
+}
+
+var unsafeChars = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+// path returns the cache file path for a key
+func path(key string) (string, error) {
+	home, err := homedir.Dir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get home directory: %w", err)
+	}
+	return filepath.Join(home, ".opsbrew", "cache", unsafeChars.ReplaceAllString(key, "_")+".yaml"), nil
+}
+
+// Get returns the cached values for key if they are younger than ttl
+func Get(key string, ttl time.Duration) ([]string, bool) {
+	p, err := path(key)
+	if err != nil {
+		return nil, false
+	}
+
+	data, err := os.ReadFile(p)
+	if err != nil {
+		return nil, false
+	}
+
+	var entry Entry
+	if err := yaml.Unmarshal(data, &entry); err != nil {
+		return nil, false
+	}
+
+	if time.Since(entry.UpdatedAt) > ttl {
+		return nil, false
+	}
+
+	return entry.Values, true
+}
+
+// Set stores values for key
+func Set(key string, values []string) error {
+	p, err := path(key)
+	if err != nil {
+		return err
+	}
+
+	data, err := yaml.Marshal(Entry{Values: values, UpdatedAt: time.Now()})
+	if err != nil {
+		return fmt.Errorf("failed to marshal cache entry: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+		return fmt.Errorf("failed to create cache directory: %w", err)
+	}
+
+	if err := os.WriteFile(p, data, 0644); err != nil {
+		return fmt.Errorf("failed to write cache entry: %w", err)
+	}
+
+	return nil
+}
+
+// Fetch returns the cached values for key, calling load and caching the result on a miss.
+// A failed load is cached as an empty result so an unreachable source is not retried until ttl passes.
+func Fetch(key string, ttl time.Duration, load func() ([]string, error)) ([]string, error) {
+	if values, ok := Get(key, ttl); ok {
+		return values, nil
+	}
+
+	values, err := load()
+	if err != nil {
+		_ = Set(key, nil)
+		return nil, err
+	}
+
+	// A failed write only costs a reload next time
+	_ = Set(key, values)
+
+	return values, nil
+}
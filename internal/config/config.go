@@ -1,11 +1,11 @@
 package config
 
 import (
-	"fmt"
 	"os"
 	"path/filepath"
 
 	"github.com/mitchellh/go-homedir"
+	"github.com/nghiadaulau/opsbrew/internal/exitcode"
 	"github.com/spf13/viper"
 	"gopkg.in/yaml.v3"
 )
@@ -13,16 +13,16 @@ import (
 // Config represents the opsbrew configuration structure
 type Config struct {
 	Git struct {
-		DefaultBranch string            `yaml:"default_branch"`
+		DefaultBranch string            `yaml:"default_branch" mapstructure:"default_branch"`
 		Aliases       map[string]string `yaml:"aliases"`
-		AutoFetch     bool              `yaml:"auto_fetch"`
+		AutoFetch     bool              `yaml:"auto_fetch" mapstructure:"auto_fetch"`
 	} `yaml:"git"`
 
 	Kubernetes struct {
-		DefaultContext  string            `yaml:"default_context"`
-		DefaultNamespace string            `yaml:"default_namespace"`
-		ContextAliases  map[string]string `yaml:"context_aliases"`
-		NamespaceAliases map[string]string `yaml:"namespace_aliases"`
+		DefaultContext  string            `yaml:"default_context" mapstructure:"default_context"`
+		DefaultNamespace string            `yaml:"default_namespace" mapstructure:"default_namespace"`
+		ContextAliases  map[string]string `yaml:"context_aliases" mapstructure:"context_aliases"`
+		NamespaceAliases map[string]string `yaml:"namespace_aliases" mapstructure:"namespace_aliases"`
 	} `yaml:"kubernetes"`
 
 	Brew struct {
@@ -33,11 +33,24 @@ type Config struct {
 		Path string `yaml:"path"`
 	} `yaml:"templates"`
 
+	Timeouts struct {
+		Default string            `yaml:"default"`
+		Tools   map[string]string `yaml:"tools"`
+	} `yaml:"timeouts"`
+
+	Telemetry struct {
+		Enabled   bool   `yaml:"enabled"`
+		Endpoint  string `yaml:"endpoint"`
+		BatchSize int    `yaml:"batch_size" mapstructure:"batch_size"`
+	} `yaml:"telemetry"`
+
 	UI struct {
 		Colors    bool `yaml:"colors"`
 		Verbose   bool `yaml:"verbose"`
 		Confirm   bool `yaml:"confirm"`
-		DryRun    bool `yaml:"dry_run"`
+		DryRun    bool `yaml:"dry_run" mapstructure:"dry_run"`
+		Locale    string `yaml:"locale"`
+		NonInteractive bool `yaml:"non_interactive" mapstructure:"non_interactive"`
 	} `yaml:"ui"`
 }
 
@@ -54,7 +67,7 @@ func LoadConfig() (*Config, error) {
 
 	// Read config from viper
 	if err := viper.Unmarshal(&cfg); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal config: %w", err)
+		return nil, exitcode.Errorf(exitcode.Config, "failed to unmarshal config: %w", err)
 	}
 
 	return &cfg, nil
@@ -65,7 +78,7 @@ func SaveConfig(cfg *Config) error {
 	// Marshal config to YAML
 	data, err := yaml.Marshal(cfg)
 	if err != nil {
-		return fmt.Errorf("failed to marshal config: %w", err)
+		return exitcode.Errorf(exitcode.Config, "failed to marshal config: %w", err)
 	}
 
 	// Get config file path
@@ -73,14 +86,14 @@ func SaveConfig(cfg *Config) error {
 	if configPath == "" {
 		home, err := homedir.Dir()
 		if err != nil {
-			return fmt.Errorf("failed to get home directory: %w", err)
+			return exitcode.Errorf(exitcode.Config, "failed to get home directory: %w", err)
 		}
 		configPath = filepath.Join(home, ".opsbrew.yaml")
 	}
 
 	// Write to file
 	if err := os.WriteFile(configPath, data, 0644); err != nil {
-		return fmt.Errorf("failed to write config file: %w", err)
+		return exitcode.Errorf(exitcode.Config, "failed to write config file: %w", err)
 	}
 
 	return nil
@@ -143,15 +156,27 @@ func CreateDefaultConfig() error {
 	// Set default Templates configuration
 	home, err := homedir.Dir()
 	if err != nil {
-		return fmt.Errorf("failed to get home directory: %w", err)
+		return exitcode.Errorf(exitcode.Config, "failed to get home directory: %w", err)
 	}
 	cfg.Templates.Path = filepath.Join(home, ".opsbrew", "templates")
 
+	// External commands have no timeout by default, so long-running steps
+	// such as kubectl port-forward are never cut off
+	cfg.Timeouts.Default = ""
+	cfg.Timeouts.Tools = map[string]string{}
+
+	// Telemetry is opt-in
+	cfg.Telemetry.Enabled = false
+	cfg.Telemetry.Endpoint = ""
+	cfg.Telemetry.BatchSize = 20
+
 	// Set default UI configuration
 	cfg.UI.Colors = true
 	cfg.UI.Verbose = false
 	cfg.UI.Confirm = false
 	cfg.UI.DryRun = false
+	cfg.UI.Locale = ""
+	cfg.UI.NonInteractive = false
 
 	return SaveConfig(cfg)
 }
@@ -162,7 +187,7 @@ func GetRepoConfig() (*Config, error) {
 	if _, err := os.Stat(".opsbrew.yaml"); err == nil {
 		viper.SetConfigFile(".opsbrew.yaml")
 		if err := viper.ReadInConfig(); err != nil {
-			return nil, fmt.Errorf("failed to read repo config: %w", err)
+			return nil, exitcode.Errorf(exitcode.Config, "failed to read repo config: %w", err)
 		}
 		return LoadConfig()
 	}
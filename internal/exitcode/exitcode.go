@@ -0,0 +1,137 @@
+package exitcode
+
+import (
+	"errors"
+	"os/exec"
+
+	"github.com/nghiadaulau/opsbrew/internal/i18n"
+)
+
+// Process exit codes returned by opsbrew. The opsbrew categories follow
+// sysexits(3) so they stay clear of codes passed through from subprocesses.
+const (
+	CodeOK          = 0
+	CodeValidation  = 64 // EX_USAGE
+	CodeGeneral     = 70 // EX_SOFTWARE
+	CodeConfig      = 78 // EX_CONFIG
+	CodeTimeout     = 124
+	CodeToolMissing = 127
+	CodeCancelled   = 130
+)
+
+// Kind categorizes an error so callers can branch on the failure type
+type Kind int
+
+const (
+	General Kind = iota
+	Validation
+	Config
+	ToolMissing
+	Cancelled
+	Subprocess
+	Timeout
+)
+
+// String returns a human-readable name for the kind
+func (k Kind) String() string {
+	switch k {
+	case Validation:
+		return "validation"
+	case Config:
+		return "config"
+	case ToolMissing:
+		return "tool-missing"
+	case Cancelled:
+		return "cancelled"
+	case Subprocess:
+		return "subprocess"
+	case Timeout:
+		return "timeout"
+	default:
+		return "general"
+	}
+}
+
+// Error is an error tagged with a Kind
+type Error struct {
+	Kind Kind
+	Err  error
+}
+
+// Error implements the error interface
+func (e *Error) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the underlying error
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// ErrCancelled is returned when the user declines a prompt or aborts a selection
+var ErrCancelled = &Error{Kind: Cancelled, Err: errors.New("operation cancelled")}
+
+// Wrap tags err with the given kind
+func Wrap(kind Kind, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &Error{Kind: kind, Err: err}
+}
+
+// Errorf formats an error with a translated format string and tags it with the given kind
+func Errorf(kind Kind, format string, a ...interface{}) error {
+	return &Error{Kind: kind, Err: i18n.Errorf(format, a...)}
+}
+
+// KindOf returns the kind of err, inferring it from exec errors when untagged
+func KindOf(err error) Kind {
+	if err == nil {
+		return General
+	}
+
+	var typed *Error
+	if errors.As(err, &typed) {
+		return typed.Kind
+	}
+
+	if errors.Is(err, exec.ErrNotFound) {
+		return ToolMissing
+	}
+
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return Subprocess
+	}
+
+	return General
+}
+
+// Code returns the process exit code for err
+func Code(err error) int {
+	if err == nil {
+		return CodeOK
+	}
+
+	switch KindOf(err) {
+	case Validation:
+		return CodeValidation
+	case Config:
+		return CodeConfig
+	case ToolMissing:
+		return CodeToolMissing
+	case Cancelled:
+		return CodeCancelled
+	case Timeout:
+		return CodeTimeout
+	case Subprocess:
+		// Pass through the exit code of the failed external command
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && exitErr.ExitCode() > 0 {
+			return exitErr.ExitCode()
+		}
+		return CodeGeneral
+	default:
+		return CodeGeneral
+	}
+}
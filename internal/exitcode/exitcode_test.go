@@ -0,0 +1,42 @@
+package exitcode
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"testing"
+)
+
+func TestCode(t *testing.T) {
+	exitErr := exec.Command("sh", "-c", "exit 3").Run()
+	if exitErr == nil {
+		t.Fatal("expected sh to fail")
+	}
+
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{"nil", nil, CodeOK},
+		{"untagged", errors.New("boom"), CodeGeneral},
+		{"tool missing", &exec.Error{Name: "kubectl", Err: exec.ErrNotFound}, CodeToolMissing},
+		{"wrapped tool missing", fmt.Errorf("failed to run: %w", &exec.Error{Name: "git", Err: exec.ErrNotFound}), CodeToolMissing},
+		{"subprocess passthrough", exitErr, 3},
+		{"wrapped subprocess", fmt.Errorf("recipe failed: %w", exitErr), 3},
+		{"validation", Wrap(Validation, errors.New("bad args")), CodeValidation},
+		{"wrapped validation", fmt.Errorf("outer: %w", Wrap(Validation, errors.New("bad args"))), CodeValidation},
+		{"config", Wrap(Config, errors.New("bad config")), CodeConfig},
+		{"timeout", Wrap(Timeout, errors.New("killed")), CodeTimeout},
+		{"cancelled", fmt.Errorf("failed to select: %w", ErrCancelled), CodeCancelled},
+		{"tag overrides subprocess", Wrap(Validation, exitErr), CodeValidation},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Code(tt.err); got != tt.want {
+				t.Errorf("Code(%v) = %d, want %d", tt.err, got, tt.want)
+			}
+		})
+	}
+}
@@ -1,12 +1,15 @@
 package git
 
 import (
+	"errors"
 	"fmt"
-	"os/exec"
 	"strings"
 
 	"github.com/fatih/color"
 	"github.com/ktr0731/go-fuzzyfinder"
+	"github.com/nghiadaulau/opsbrew/internal/exitcode"
+	"github.com/nghiadaulau/opsbrew/internal/i18n"
+	"github.com/nghiadaulau/opsbrew/internal/runner"
 )
 
 // FileStatus represents the status of a git file
@@ -82,18 +85,18 @@ func ParseStatus(output string) *GitStatus {
 // DisplayStatus displays git status with colors
 func DisplayStatus(status *GitStatus, useColors bool) {
 	if useColors {
-		color.Green("=== Git Status ===")
+		color.Green(i18n.T("=== Git Status ==="))
 	} else {
-		fmt.Println("=== Git Status ===")
+		fmt.Println(i18n.T("=== Git Status ==="))
 	}
 
 	// Show current branch
 	branch, err := getCurrentBranch()
 	if err == nil {
 		if useColors {
-			color.Cyan("On branch: %s", branch)
+			color.Cyan(i18n.T("On branch: %s"), branch)
 		} else {
-			fmt.Printf("On branch: %s\n", branch)
+			fmt.Printf(i18n.T("On branch: %s\n"), branch)
 		}
 	}
 
@@ -102,9 +105,9 @@ func DisplayStatus(status *GitStatus, useColors bool) {
 	// Display staged changes
 	if len(status.Staged) > 0 {
 		if useColors {
-			color.Green("Changes to be committed:")
+			color.Green(i18n.T("Changes to be committed:"))
 		} else {
-			fmt.Println("Changes to be committed:")
+			fmt.Println(i18n.T("Changes to be committed:"))
 		}
 		for _, file := range status.Staged {
 			if useColors {
@@ -119,9 +122,9 @@ func DisplayStatus(status *GitStatus, useColors bool) {
 	// Display modified files
 	if len(status.Modified) > 0 {
 		if useColors {
-			color.Yellow("Changes not staged for commit:")
+			color.Yellow(i18n.T("Changes not staged for commit:"))
 		} else {
-			fmt.Println("Changes not staged for commit:")
+			fmt.Println(i18n.T("Changes not staged for commit:"))
 		}
 		for _, file := range status.Modified {
 			if useColors {
@@ -136,9 +139,9 @@ func DisplayStatus(status *GitStatus, useColors bool) {
 	// Display untracked files
 	if len(status.Untracked) > 0 {
 		if useColors {
-			color.Red("Untracked files:")
+			color.Red(i18n.T("Untracked files:"))
 		} else {
-			fmt.Println("Untracked files:")
+			fmt.Println(i18n.T("Untracked files:"))
 		}
 		for _, file := range status.Untracked {
 			if useColors {
@@ -153,9 +156,9 @@ func DisplayStatus(status *GitStatus, useColors bool) {
 	// Display conflicted files
 	if len(status.Conflicted) > 0 {
 		if useColors {
-			color.Red("Unmerged paths:")
+			color.Red(i18n.T("Unmerged paths:"))
 		} else {
-			fmt.Println("Unmerged paths:")
+			fmt.Println(i18n.T("Unmerged paths:"))
 		}
 		for _, file := range status.Conflicted {
 			if useColors {
@@ -171,9 +174,9 @@ func DisplayStatus(status *GitStatus, useColors bool) {
 	totalChanges := len(status.Staged) + len(status.Modified) + len(status.Untracked) + len(status.Deleted) + len(status.Renamed) + len(status.Conflicted)
 	if totalChanges == 0 {
 		if useColors {
-			color.Green("Working tree clean")
+			color.Green(i18n.T("Working tree clean"))
 		} else {
-			fmt.Println("Working tree clean")
+			fmt.Println(i18n.T("Working tree clean"))
 		}
 	}
 }
@@ -181,22 +184,22 @@ func DisplayStatus(status *GitStatus, useColors bool) {
 // GetBranches returns all available branches
 func GetBranches() ([]Branch, error) {
 	// Get local branches
-	localOutput, err := exec.Command("git", "branch", "--format=%(refname:short)").Output()
+	localOutput, err := runner.Command("git", "branch", "--format=%(refname:short)").Output()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get local branches: %w", err)
+		return nil, i18n.Errorf("failed to get local branches: %w", err)
 	}
 
 	// Get current branch
-	currentOutput, err := exec.Command("git", "branch", "--show-current").Output()
+	currentOutput, err := runner.Command("git", "branch", "--show-current").Output()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get current branch: %w", err)
+		return nil, i18n.Errorf("failed to get current branch: %w", err)
 	}
 	currentBranch := strings.TrimSpace(string(currentOutput))
 
 	// Get remote branches
-	remoteOutput, err := exec.Command("git", "branch", "-r", "--format=%(refname:short)").Output()
+	remoteOutput, err := runner.Command("git", "branch", "-r", "--format=%(refname:short)").Output()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get remote branches: %w", err)
+		return nil, i18n.Errorf("failed to get remote branches: %w", err)
 	}
 
 	var branches []Branch
@@ -257,6 +260,9 @@ func SelectBranch(branches []Branch) (string, error) {
 		}),
 	)
 	if err != nil {
+		if errors.Is(err, fuzzyfinder.ErrAbort) {
+			return "", exitcode.ErrCancelled
+		}
 		return "", err
 	}
 
@@ -265,12 +271,12 @@ func SelectBranch(branches []Branch) (string, error) {
 
 // DisplayBranches displays branches with formatting
 func DisplayBranches(branches []Branch) {
-	fmt.Println("=== Branches ===")
+	fmt.Println(i18n.T("=== Branches ==="))
 	for _, branch := range branches {
 		if branch.Current {
 			color.Cyan("  * %s", branch.Name)
 		} else if branch.Remote {
-			fmt.Printf("    %s (remote)\n", branch.Name)
+			fmt.Printf(i18n.T("    %s (remote)\n"), branch.Name)
 		} else {
 			fmt.Printf("    %s\n", branch.Name)
 		}
@@ -279,7 +285,7 @@ func DisplayBranches(branches []Branch) {
 
 // getCurrentBranch returns the current branch name
 func getCurrentBranch() (string, error) {
-	output, err := exec.Command("git", "branch", "--show-current").Output()
+	output, err := runner.Command("git", "branch", "--show-current").Output()
 	if err != nil {
 		return "", err
 	}
@@ -0,0 +1,84 @@
+package i18n
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// DefaultLocale is used when no supported locale is configured
+const DefaultLocale = "en"
+
+// catalogs maps a locale to translations keyed by the English message.
+// English messages are used as-is, so the "en" catalog is empty.
+var catalogs = map[string]map[string]string{
+	"en": {},
+	"vi": vi,
+}
+
+var current = DefaultLocale
+
+// Supported returns the supported locale codes
+func Supported() []string {
+	return []string{"en", "vi"}
+}
+
+// Normalize reduces a locale such as "vi_VN.UTF-8" to a supported code, or "" if unsupported
+func Normalize(locale string) string {
+	locale = strings.ToLower(strings.TrimSpace(locale))
+	if i := strings.IndexAny(locale, "_-.@"); i >= 0 {
+		locale = locale[:i]
+	}
+	if _, ok := catalogs[locale]; ok {
+		return locale
+	}
+	return ""
+}
+
+// Detect picks the locale from the configured value, then LC_ALL, LC_MESSAGES, and LANG
+func Detect(configured string) string {
+	candidates := []string{configured, os.Getenv("LC_ALL"), os.Getenv("LC_MESSAGES"), os.Getenv("LANG")}
+	for _, candidate := range candidates {
+		if candidate == "" {
+			continue
+		}
+		// An explicit but unsupported setting still decides the locale
+		if locale := Normalize(candidate); locale != "" {
+			return locale
+		}
+		return DefaultLocale
+	}
+	return DefaultLocale
+}
+
+// SetLocale selects the locale used for messages
+func SetLocale(locale string) {
+	if normalized := Normalize(locale); normalized != "" {
+		current = normalized
+		return
+	}
+	current = DefaultLocale
+}
+
+// Locale returns the selected locale
+func Locale() string {
+	return current
+}
+
+// T returns the translation of an English message or format string
+func T(message string) string {
+	if translated, ok := catalogs[current][message]; ok {
+		return translated
+	}
+	return message
+}
+
+// Sprintf formats a translated format string
+func Sprintf(format string, a ...interface{}) string {
+	return fmt.Sprintf(T(format), a...)
+}
+
+// Errorf returns an error with a translated format string, supporting %w
+func Errorf(format string, a ...interface{}) error {
+	return fmt.Errorf(T(format), a...)
+}
@@ -0,0 +1,61 @@
+package i18n
+
+import "testing"
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		locale string
+		want   string
+	}{
+		{"vi", "vi"},
+		{"vi_VN.UTF-8", "vi"},
+		{"vi-VN", "vi"},
+		{" VI ", "vi"},
+		{"en_US.UTF-8", "en"},
+		{"en@euro", "en"},
+		{"C", ""},
+		{"POSIX", ""},
+		{"fr_FR.UTF-8", ""},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.locale, func(t *testing.T) {
+			if got := Normalize(tt.locale); got != tt.want {
+				t.Errorf("Normalize(%q) = %q, want %q", tt.locale, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDetect(t *testing.T) {
+	tests := []struct {
+		name       string
+		configured string
+		lcAll      string
+		lcMessages string
+		lang       string
+		want       string
+	}{
+		{"nothing set", "", "", "", "", DefaultLocale},
+		{"config wins over environment", "vi", "en_US.UTF-8", "", "en_US.UTF-8", "vi"},
+		{"LC_ALL", "", "vi_VN.UTF-8", "", "", "vi"},
+		{"LC_ALL before LC_MESSAGES", "", "vi_VN.UTF-8", "en_US.UTF-8", "", "vi"},
+		{"LC_MESSAGES before LANG", "", "", "vi_VN.UTF-8", "en_US.UTF-8", "vi"},
+		{"LANG", "", "", "", "vi_VN.UTF-8", "vi"},
+		{"unsupported LC_ALL still decides", "", "C", "", "vi_VN.UTF-8", DefaultLocale},
+		{"unsupported config still decides", "fr", "", "", "vi_VN.UTF-8", DefaultLocale},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("LC_ALL", tt.lcAll)
+			t.Setenv("LC_MESSAGES", tt.lcMessages)
+			t.Setenv("LANG", tt.lang)
+
+			if got := Detect(tt.configured); got != tt.want {
+				t.Errorf("Detect(%q) = %q, want %q", tt.configured, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,231 @@
+package i18n
+
+// vi is the Vietnamese message catalog
+var vi = map[string]string{
+	"    %s (remote)\n":             "    %s (từ xa)\n",
+	"    Commands: %d\n":            "    Số lệnh: %d\n",
+	"    Description: %s\n":         "    Mô tả: %s\n",
+	"    Files: %d\n":               "    Số tệp: %d\n",
+	"    Tags: %s\n":                "    Thẻ: %s\n",
+	"  Batch size: %d\n":            "  Kích thước lô: %d\n",
+	"  Enabled: no":                 "  Đã bật: không",
+	"  Enabled: yes":                "  Đã bật: có",
+	"  Endpoint: %s\n":              "  Điểm nhận: %s\n",
+	"  Queued events: %d\n":         "  Sự kiện đang chờ: %d\n",
+	"%s (recipe)":                   "%s (công thức)",
+	"%s killed after timeout of %s": "%s đã bị dừng sau khi hết thời gian chờ %s",
+	"(not configured)":              "(chưa cấu hình)",
+	"=== Available Templates ===":   "=== Các mẫu có sẵn ===",
+	"=== Branches ===":              "=== Các nhánh ===",
+	"=== Git Status ===":            "=== Trạng thái Git ===",
+	"=== Pods ===":                  "=== Các pod ===",
+	"=== Saved Recipes ===":         "=== Các công thức đã lưu ===",
+	"=== Telemetry ===":             "=== Thống kê sử dụng ===",
+	"Arguments for %s %s":           "Tham số cho %s %s",
+	"Branch %s not found locally, checking out from remote...": "Không tìm thấy nhánh %s ở máy, đang checkout từ remote...",
+	"Changes not staged for commit:":                           "Thay đổi chưa được đưa vào stage:",
+	"Changes to be committed:":                                 "Thay đổi sẽ được commit:",
+	"Command failed: %s":                                       "Lệnh thất bại: %s",
+	"Command: opsbrew %s":                                      "Lệnh: opsbrew %s",
+	"Commands:":                                                "Các lệnh:",
+	"Created backup: %s":                                       "Đã tạo bản sao lưu: %s",
+	"Created default config file: %s":                          "Đã tạo tệp cấu hình mặc định: %s",
+	"Current recipe '%s':\n":                                   "Công thức hiện tại '%s':\n",
+	"Delete recipe '%s'? This cannot be undone.":               "Xóa công thức '%s'? Thao tác này không thể hoàn tác.",
+	"Description: %s":                                          "Mô tả: %s",
+	"Description: %s\n":                                        "Mô tả: %s\n",
+	"Enter commands for recipe '%s' (one per line, empty line to finish):": "Nhập các lệnh cho công thức '%s' (mỗi dòng một lệnh, dòng trống để kết thúc):",
+	"Enter new commands (one per line, empty line to keep current):":       "Nhập các lệnh mới (mỗi dòng một lệnh, dòng trống để giữ nguyên):",
+	"Did you mean this?":                                             "Ý bạn là:",
+	"Error creating default config: %v":                              "Lỗi khi tạo cấu hình mặc định: %v",
+	"Error reading input: %v":                                        "Lỗi khi đọc dữ liệu nhập: %v",
+	"Error: %v\n":                                                    "Lỗi: %v\n",
+	"Error: failed to read config file: %v\n":                        "Lỗi: không đọc được tệp cấu hình: %v\n",
+	"Executing command %d/%d: %s":                                    "Đang chạy lệnh %d/%d: %s",
+	"Failed to record telemetry: %v":                                 "Không ghi được thống kê sử dụng: %v",
+	"Failed to record usage: %v":                                     "Không ghi được lịch sử sử dụng: %v",
+	"Failed to send telemetry: %v":                                   "Không gửi được thống kê sử dụng: %v",
+	"Fetch completed successfully":                                   "Fetch hoàn tất",
+	"Fetching all remotes...":                                        "Đang fetch tất cả remote...",
+	"Files are identical":                                            "Hai tệp giống hệt nhau",
+	"Found %d files:":                                                "Tìm thấy %d tệp:",
+	"HPA name and value are required":                                "cần tên HPA và giá trị",
+	"HPA name is required":                                           "cần tên HPA",
+	"Ignoring usage history: %v":                                     "Bỏ qua lịch sử sử dụng: %v",
+	"Invalid timeout for %s %q: %v":                                  "Thời gian chờ không hợp lệ cho %s %q: %v",
+	"Invalid timeouts.default %q: %v":                                "timeouts.default không hợp lệ %q: %v",
+	"New description (press Enter to keep current)":                  "Mô tả mới (nhấn Enter để giữ nguyên)",
+	"New tags (comma-separated, press Enter to keep current)":        "Thẻ mới (cách nhau bởi dấu phẩy, nhấn Enter để giữ nguyên)",
+	"No files found matching pattern: %s":                            "Không tìm thấy tệp nào khớp mẫu: %s",
+	"No matches found for pattern: %s":                               "Không có kết quả nào khớp mẫu: %s",
+	"No recipes found":                                               "Không có công thức nào",
+	"No telemetry.endpoint configured; events are queued locally":    "Chưa cấu hình telemetry.endpoint; sự kiện được lưu tạm trên máy",
+	"On branch: %s":                                                  "Đang ở nhánh: %s",
+	"On branch: %s\n":                                                "Đang ở nhánh: %s\n",
+	"Opened file: %s":                                                "Đã mở tệp: %s",
+	"Operation cancelled":                                            "Đã hủy thao tác",
+	"Output directory: %s":                                           "Thư mục đầu ra: %s",
+	"Please answer yes or no":                                        "Vui lòng trả lời có hoặc không",
+	"Project initialized successfully!":                              "Khởi tạo dự án thành công!",
+	"Project name: %s":                                               "Tên dự án: %s",
+	"Pull completed successfully":                                    "Pull hoàn tất",
+	"Pull with rebase?":                                              "Pull với rebase?",
+	"Pulling from current branch...":                                 "Đang pull từ nhánh hiện tại...",
+	"Push completed successfully":                                    "Push hoàn tất",
+	"Pushing to current branch...":                                   "Đang push lên nhánh hiện tại...",
+	"Recipe '%s' completed successfully":                             "Công thức '%s' đã chạy xong",
+	"Recipe '%s' deleted successfully":                               "Đã xóa công thức '%s'",
+	"Recipe '%s' saved successfully":                                 "Đã lưu công thức '%s'",
+	"Recipe '%s' updated successfully":                               "Đã cập nhật công thức '%s'",
+	"Run recipe '%s'?":                                               "Chạy công thức '%s'?",
+	"Running recipe: %s":                                             "Đang chạy công thức: %s",
+	"Running: %s":                                                    "Đang chạy: %s",
+	"Scaled %s %s to %s replicas":                                    "Đã scale %s %s lên %s bản sao",
+	"Set max replicas to %s for HPA %s":                              "Đã đặt số bản sao tối đa là %s cho HPA %s",
+	"Set min replicas to %s for HPA %s":                              "Đã đặt số bản sao tối thiểu là %s cho HPA %s",
+	"Set target CPU to %s%% for HPA %s":                              "Đã đặt mục tiêu CPU là %s%% cho HPA %s",
+	"Switched to branch: %s":                                         "Đã chuyển sang nhánh: %s",
+	"Switched to context: %s":                                        "Đã chuyển sang context: %s",
+	"Switched to namespace: %s":                                      "Đã chuyển sang namespace: %s",
+	"Sync completed successfully":                                    "Đồng bộ hoàn tất",
+	"Syncing branch: %s":                                             "Đang đồng bộ nhánh: %s",
+	"Tags: %s\n":                                                     "Thẻ: %s\n",
+	"Telemetry disabled":                                             "Đã tắt thống kê sử dụng",
+	"Telemetry enabled":                                              "Đã bật thống kê sử dụng",
+	"Unmerged paths:":                                                "Đường dẫn chưa merge:",
+	"Untracked files:":                                               "Tệp chưa được theo dõi:",
+	"Used: %d times (last %s)":                                       "Đã dùng: %d lần (gần nhất %s)",
+	"Using config file: %s":                                          "Đang dùng tệp cấu hình: %s",
+	"Working tree clean":                                             "Cây làm việc sạch",
+	"Would create backup of file: %s":                                "Sẽ tạo bản sao lưu cho tệp: %s",
+	"Would delete recipe: %s":                                        "Sẽ xóa công thức: %s",
+	"Would disable telemetry and discard queued events":              "Sẽ tắt thống kê sử dụng và xóa các sự kiện đang chờ",
+	"Would enable telemetry":                                         "Sẽ bật thống kê sử dụng",
+	"Would initialize template: %s":                                  "Sẽ khởi tạo mẫu: %s",
+	"Would open file: %s":                                            "Sẽ mở tệp: %s",
+	"Would run recipe '%s':":                                         "Sẽ chạy công thức '%s':",
+	"Would run: %s":                                                  "Sẽ chạy: %s",
+	"Would run: git checkout %s":                                     "Sẽ chạy: git checkout %s",
+	"Would run: git fetch --all":                                     "Sẽ chạy: git fetch --all",
+	"Would run: git pull":                                            "Sẽ chạy: git pull",
+	"Would run: git pull --rebase":                                   "Sẽ chạy: git pull --rebase",
+	"Would run: git push":                                            "Sẽ chạy: git push",
+	"Would run: git status":                                          "Sẽ chạy: git status",
+	"Would run: kubectl config set-context --current --namespace=%s": "Sẽ chạy: kubectl config set-context --current --namespace=%s",
+	"Would run: kubectl config use-context %s":                       "Sẽ chạy: kubectl config use-context %s",
+	"Would run: kubectl exec -it %s -- %s":                           "Sẽ chạy: kubectl exec -it %s -- %s",
+	"Would run: kubectl get hpa":                                     "Sẽ chạy: kubectl get hpa",
+	"Would run: kubectl get hpa %s -o yaml":                          "Sẽ chạy: kubectl get hpa %s -o yaml",
+	"Would run: kubectl get hpa %s -o yaml -n %s":                    "Sẽ chạy: kubectl get hpa %s -o yaml -n %s",
+	"Would run: kubectl get hpa -n %s":                               "Sẽ chạy: kubectl get hpa -n %s",
+	"Would run: kubectl get ingress":                                 "Sẽ chạy: kubectl get ingress",
+	"Would run: kubectl get services":                                "Sẽ chạy: kubectl get services",
+	"Would run: kubectl patch hpa %s -p '{\"spec\":{\"maxReplicas\":%s}}'":                                                                                                    "Sẽ chạy: kubectl patch hpa %s -p '{\"spec\":{\"maxReplicas\":%s}}'",
+	"Would run: kubectl patch hpa %s -p '{\"spec\":{\"maxReplicas\":%s}}' -n %s":                                                                                              "Sẽ chạy: kubectl patch hpa %s -p '{\"spec\":{\"maxReplicas\":%s}}' -n %s",
+	"Would run: kubectl patch hpa %s -p '{\"spec\":{\"metrics\":[{\"resource\":{\"name\":\"cpu\",\"target\":{\"type\":\"Utilization\",\"averageUtilization\":%s}}}]}}'":       "Sẽ chạy: kubectl patch hpa %s -p '{\"spec\":{\"metrics\":[{\"resource\":{\"name\":\"cpu\",\"target\":{\"type\":\"Utilization\",\"averageUtilization\":%s}}}]}}'",
+	"Would run: kubectl patch hpa %s -p '{\"spec\":{\"metrics\":[{\"resource\":{\"name\":\"cpu\",\"target\":{\"type\":\"Utilization\",\"averageUtilization\":%s}}}]}}' -n %s": "Sẽ chạy: kubectl patch hpa %s -p '{\"spec\":{\"metrics\":[{\"resource\":{\"name\":\"cpu\",\"target\":{\"type\":\"Utilization\",\"averageUtilization\":%s}}}]}}' -n %s",
+	"Would run: kubectl patch hpa %s -p '{\"spec\":{\"minReplicas\":%s}}'":                                                                                                    "Sẽ chạy: kubectl patch hpa %s -p '{\"spec\":{\"minReplicas\":%s}}'",
+	"Would run: kubectl patch hpa %s -p '{\"spec\":{\"minReplicas\":%s}}' -n %s":                                                                                              "Sẽ chạy: kubectl patch hpa %s -p '{\"spec\":{\"minReplicas\":%s}}' -n %s",
+	"Would run: kubectl scale %s %s --replicas=%s":                                                                                                                            "Sẽ chạy: kubectl scale %s %s --replicas=%s",
+	"Would run: kubectl scale %s %s --replicas=%s -n %s":                                                                                                                      "Sẽ chạy: kubectl scale %s %s --replicas=%s -n %s",
+	"Would search for '%s' in file '%s'":                                                                                                                                      "Sẽ tìm '%s' trong tệp '%s'",
+	"Would search for pattern '%s' in directory '%s'":                                                                                                                         "Sẽ tìm mẫu '%s' trong thư mục '%s'",
+	"Would show diff between '%s' and '%s'":                                                                                                                                   "Sẽ hiển thị khác biệt giữa '%s' và '%s'",
+	"[Y/n]":                                                                                                                                                                   "[C/k]",
+	"[y/N]":                                                                                                                                                                   "[c/K]",
+	"action is required (list, get, set-min, set-max, set-target)":                                                                                                            "cần chỉ định hành động (list, get, set-min, set-max, set-target)",
+	"branch":                                              "nhánh",
+	"context":                                             "tên context",
+	"failed to checkout branch %s: %w":                    "không checkout được nhánh %s: %w",
+	"failed to clear telemetry queue: %w":                 "không xóa được hàng đợi thống kê sử dụng: %w",
+	"failed to compare files: %w":                         "không so sánh được các tệp: %w",
+	"failed to create backup: %w":                         "không tạo được bản sao lưu: %w",
+	"failed to create directory %s: %w":                   "không tạo được thư mục %s: %w",
+	"failed to create file %s: %w":                        "không tạo được tệp %s: %w",
+	"failed to create history directory: %w":              "không tạo được thư mục lịch sử: %w",
+	"failed to create output directory: %w":               "không tạo được thư mục đầu ra: %w",
+	"failed to create telemetry directory: %w":            "không tạo được thư mục thống kê sử dụng: %w",
+	"failed to delete recipe: %w":                         "không xóa được công thức: %w",
+	"failed to disable telemetry: %w":                     "không tắt được thống kê sử dụng: %w",
+	"failed to enable telemetry: %w":                      "không bật được thống kê sử dụng: %w",
+	"failed to execute command: %w":                       "không thực thi được lệnh: %w",
+	"failed to execute template for %s: %w":               "không thực thi được mẫu cho %s: %w",
+	"failed to fetch: %w":                                 "fetch thất bại: %w",
+	"failed to find command %s: %w":                       "không tìm thấy lệnh %s: %w",
+	"failed to find files: %w":                            "không tìm được tệp: %w",
+	"failed to get HPA %s: %w":                            "không lấy được HPA %s: %w",
+	"failed to get branches: %w":                          "không lấy được danh sách nhánh: %w",
+	"failed to get contexts: %w":                          "không lấy được danh sách context: %w",
+	"failed to get current branch: %w":                    "không lấy được nhánh hiện tại: %w",
+	"failed to get current context: %w":                   "không lấy được context hiện tại: %w",
+	"failed to get current namespace: %w":                 "không lấy được namespace hiện tại: %w",
+	"failed to get git status: %w":                        "không lấy được trạng thái git: %w",
+	"failed to get home directory: %w":                    "không xác định được thư mục home: %w",
+	"failed to get ingress: %w":                           "không lấy được ingress: %w",
+	"failed to get local branches: %w":                    "không lấy được các nhánh cục bộ: %w",
+	"failed to get logs: %w":                              "không lấy được log: %w",
+	"failed to get namespaces: %w":                        "không lấy được danh sách namespace: %w",
+	"failed to get pods: %w":                              "không lấy được danh sách pod: %w",
+	"failed to get remote branches: %w":                   "không lấy được các nhánh từ xa: %w",
+	"failed to get services: %w":                          "không lấy được danh sách service: %w",
+	"failed to initialize template: %w":                   "không khởi tạo được mẫu: %w",
+	"failed to list HPAs: %w":                             "không liệt kê được HPA: %w",
+	"failed to load config: %w":                           "không tải được cấu hình: %w",
+	"failed to marshal config: %w":                        "không mã hóa được cấu hình: %w",
+	"failed to marshal telemetry batch: %w":               "không mã hóa được lô thống kê sử dụng: %w",
+	"failed to marshal telemetry queue: %w":               "không mã hóa được hàng đợi thống kê sử dụng: %w",
+	"failed to marshal usage history: %w":                 "không mã hóa được lịch sử sử dụng: %w",
+	"failed to open file: %w":                             "không mở được tệp: %w",
+	"failed to parse telemetry queue: %w":                 "không phân tích được hàng đợi thống kê sử dụng: %w",
+	"failed to parse template for %s: %w":                 "không phân tích được mẫu cho %s: %w",
+	"failed to parse usage history: %w":                   "không phân tích được lịch sử sử dụng: %w",
+	"failed to pull: %w":                                  "pull thất bại: %w",
+	"failed to push: %w":                                  "push thất bại: %w",
+	"failed to read repo config: %w":                      "không đọc được cấu hình của repo: %w",
+	"failed to read telemetry queue: %w":                  "không đọc được hàng đợi thống kê sử dụng: %w",
+	"failed to read usage history: %w":                    "không đọc được lịch sử sử dụng: %w",
+	"failed to save recipe: %w":                           "không lưu được công thức: %w",
+	"failed to scale %s %s: %w":                           "không scale được %s %s: %w",
+	"failed to search file: %w":                           "không tìm kiếm được trong tệp: %w",
+	"failed to select branch: %w":                         "không chọn được nhánh: %w",
+	"failed to select command: %w":                        "không chọn được lệnh: %w",
+	"failed to select context: %w":                        "không chọn được context: %w",
+	"failed to select namespace: %w":                      "không chọn được namespace: %w",
+	"failed to select pod: %w":                            "không chọn được pod: %w",
+	"failed to send telemetry: %w":                        "không gửi được thống kê sử dụng: %w",
+	"failed to send telemetry: unexpected status %s":      "không gửi được thống kê sử dụng: trạng thái không mong đợi %s",
+	"failed to set max replicas for HPA %s: %w":           "không đặt được số bản sao tối đa cho HPA %s: %w",
+	"failed to set min replicas for HPA %s: %w":           "không đặt được số bản sao tối thiểu cho HPA %s: %w",
+	"failed to set permissions for %s: %w":                "không đặt được quyền cho %s: %w",
+	"failed to set target CPU for HPA %s: %w":             "không đặt được mục tiêu CPU cho HPA %s: %w",
+	"failed to switch context: %w":                        "không chuyển được context: %w",
+	"failed to switch namespace: %w":                      "không chuyển được namespace: %w",
+	"failed to sync: %w":                                  "đồng bộ thất bại: %w",
+	"failed to unmarshal config: %w":                      "không đọc được nội dung cấu hình: %w",
+	"failed to write config file: %w":                     "không ghi được tệp cấu hình: %w",
+	"failed to write telemetry queue: %w":                 "không ghi được hàng đợi thống kê sử dụng: %w",
+	"failed to write usage history: %w":                   "không ghi được lịch sử sử dụng: %w",
+	"file %s already exists (use --force to overwrite)":   "tệp %s đã tồn tại (dùng --force để ghi đè)",
+	"file %s does not exist":                              "tệp %s không tồn tại",
+	"file path is required":                               "cần đường dẫn tệp",
+	"input required for %q but running non-interactively": "cần nhập giá trị cho %q nhưng đang chạy ở chế độ không tương tác",
+	"n":                           "k",
+	"namespace":                   "tên namespace",
+	"no":                          "không",
+	"no commands provided":        "chưa nhập lệnh nào",
+	"pod":                         "tên pod",
+	"recipe '%s' not found":       "không tìm thấy công thức '%s'",
+	"recipe execution failed: %w": "chạy công thức thất bại: %w",
+	"recipe name is required":     "cần tên công thức",
+	"resource type, name, and replicas are required": "cần loại tài nguyên, tên và số bản sao",
+	"search pattern and file path are required":      "cần mẫu tìm kiếm và đường dẫn tệp",
+	"search pattern is required":                     "cần mẫu tìm kiếm",
+	"template '%s' not found":                        "không tìm thấy mẫu '%s'",
+	"template name is required":                      "cần tên mẫu",
+	"two file paths are required":                    "cần hai đường dẫn tệp",
+	"unknown action: %s":                             "hành động không xác định: %s",
+	"unknown command %q for %q":                      "lệnh không xác định %q cho %q",
+	"unsupported operating system: %s":               "hệ điều hành không được hỗ trợ: %s",
+	"y":                                              "c",
+	"yes":                                            "có",
+}
@@ -1,12 +1,15 @@
 package kubernetes
 
 import (
+	"errors"
 	"fmt"
-	"os/exec"
 	"strings"
 
 	"github.com/fatih/color"
 	"github.com/ktr0731/go-fuzzyfinder"
+	"github.com/nghiadaulau/opsbrew/internal/exitcode"
+	"github.com/nghiadaulau/opsbrew/internal/i18n"
+	"github.com/nghiadaulau/opsbrew/internal/runner"
 )
 
 // Context represents a kubectl context
@@ -34,16 +37,15 @@ type Pod struct {
 
 // GetContexts returns all available kubectl contexts
 func GetContexts() ([]Context, error) {
-	output, err := exec.Command("kubectl", "config", "get-contexts", "--no-headers", "-o", "name").Output()
+	output, err := runner.Command("kubectl", "config", "get-contexts", "--no-headers", "-o", "name").Output()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get contexts: %w", err)
+		return nil, i18n.Errorf("failed to get contexts: %w", err)
 	}
 
-	currentOutput, err := exec.Command("kubectl", "config", "current-context").Output()
+	currentContext, err := GetCurrentContext()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get current context: %w", err)
+		return nil, err
 	}
-	currentContext := strings.TrimSpace(string(currentOutput))
 
 	var contexts []Context
 	lines := strings.Split(strings.TrimSpace(string(output)), "\n")
@@ -60,6 +62,28 @@ func GetContexts() ([]Context, error) {
 	return contexts, nil
 }
 
+// GetCurrentContext returns the name of the current kubectl context
+func GetCurrentContext() (string, error) {
+	output, err := runner.Command("kubectl", "config", "current-context").Output()
+	if err != nil {
+		return "", i18n.Errorf("failed to get current context: %w", err)
+	}
+	return strings.TrimSpace(string(output)), nil
+}
+
+// GetCurrentNamespace returns the namespace of the current kubectl context
+func GetCurrentNamespace() (string, error) {
+	output, err := runner.Command("kubectl", "config", "view", "--minify", "-o", "jsonpath={..namespace}").Output()
+	if err != nil {
+		return "", i18n.Errorf("failed to get current namespace: %w", err)
+	}
+	namespace := strings.TrimSpace(string(output))
+	if namespace == "" {
+		namespace = "default"
+	}
+	return namespace, nil
+}
+
 // SelectContext uses fuzzy finder to select a context
 func SelectContext(contexts []Context) (string, error) {
 	idx, err := fuzzyfinder.Find(
@@ -80,6 +104,9 @@ func SelectContext(contexts []Context) (string, error) {
 		}),
 	)
 	if err != nil {
+		if errors.Is(err, fuzzyfinder.ErrAbort) {
+			return "", exitcode.ErrCancelled
+		}
 		return "", err
 	}
 
@@ -88,18 +115,14 @@ func SelectContext(contexts []Context) (string, error) {
 
 // GetNamespaces returns all available namespaces
 func GetNamespaces() ([]Namespace, error) {
-	output, err := exec.Command("kubectl", "get", "namespaces", "--no-headers", "-o", "custom-columns=NAME:.metadata.name,STATUS:.status.phase").Output()
+	output, err := runner.Command("kubectl", "get", "namespaces", "--no-headers", "-o", "custom-columns=NAME:.metadata.name,STATUS:.status.phase").Output()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get namespaces: %w", err)
+		return nil, i18n.Errorf("failed to get namespaces: %w", err)
 	}
 
-	currentOutput, err := exec.Command("kubectl", "config", "view", "--minify", "-o", "jsonpath={..namespace}").Output()
+	currentNamespace, err := GetCurrentNamespace()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get current namespace: %w", err)
-	}
-	currentNamespace := strings.TrimSpace(string(currentOutput))
-	if currentNamespace == "" {
-		currentNamespace = "default"
+		return nil, err
 	}
 
 	var namespaces []Namespace
@@ -141,6 +164,9 @@ func SelectNamespace(namespaces []Namespace) (string, error) {
 		}),
 	)
 	if err != nil {
+		if errors.Is(err, fuzzyfinder.ErrAbort) {
+			return "", exitcode.ErrCancelled
+		}
 		return "", err
 	}
 
@@ -149,9 +175,9 @@ func SelectNamespace(namespaces []Namespace) (string, error) {
 
 // GetPods returns all pods in the current namespace
 func GetPods() ([]Pod, error) {
-	output, err := exec.Command("kubectl", "get", "pods", "--no-headers", "-o", "custom-columns=NAME:.metadata.name,READY:.status.containerStatuses[*].ready,STATUS:.status.phase,RESTARTS:.status.containerStatuses[*].restartCount,AGE:.metadata.creationTimestamp").Output()
+	output, err := runner.Command("kubectl", "get", "pods", "--no-headers", "-o", "custom-columns=NAME:.metadata.name,READY:.status.containerStatuses[*].ready,STATUS:.status.phase,RESTARTS:.status.containerStatuses[*].restartCount,AGE:.metadata.creationTimestamp").Output()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get pods: %w", err)
+		return nil, i18n.Errorf("failed to get pods: %w", err)
 	}
 
 	var pods []Pod
@@ -193,6 +219,9 @@ func SelectPod(pods []Pod) (string, error) {
 		}),
 	)
 	if err != nil {
+		if errors.Is(err, fuzzyfinder.ErrAbort) {
+			return "", exitcode.ErrCancelled
+		}
 		return "", err
 	}
 
@@ -201,7 +230,7 @@ func SelectPod(pods []Pod) (string, error) {
 
 // DisplayPods displays pods with formatting
 func DisplayPods(pods []Pod) {
-	fmt.Println("=== Pods ===")
+	fmt.Println(i18n.T("=== Pods ==="))
 	for _, pod := range pods {
 		statusColor := getStatusColor(pod.Status)
 		statusColor.Printf("  %s (%s) - %s\n", pod.Name, pod.Status, pod.Ready)
@@ -0,0 +1,144 @@
+package launcher
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ktr0731/go-fuzzyfinder"
+	"github.com/mitchellh/go-homedir"
+	"github.com/nghiadaulau/opsbrew/internal/exitcode"
+	"github.com/nghiadaulau/opsbrew/internal/i18n"
+	"gopkg.in/yaml.v3"
+)
+
+// Entry represents a launchable command or recipe
+type Entry struct {
+	Name        string
+	Description string
+	Args        []string
+	Recipe      bool
+}
+
+// Usage records how often and how recently an entry was launched
+type Usage struct {
+	Count    int       `yaml:"count"`
+	LastUsed time.Time `yaml:"last_used"`
+}
+
+// historyPath returns the path of the launcher usage history file
+func historyPath() (string, error) {
+	home, err := homedir.Dir()
+	if err != nil {
+		return "", i18n.Errorf("failed to get home directory: %w", err)
+	}
+	return filepath.Join(home, ".opsbrew", "history.yaml"), nil
+}
+
+// LoadUsage loads the launcher usage history
+func LoadUsage() (map[string]Usage, error) {
+	usage := map[string]Usage{}
+
+	path, err := historyPath()
+	if err != nil {
+		return usage, err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return usage, nil
+		}
+		return usage, i18n.Errorf("failed to read usage history: %w", err)
+	}
+
+	if err := yaml.Unmarshal(data, &usage); err != nil {
+		return map[string]Usage{}, i18n.Errorf("failed to parse usage history: %w", err)
+	}
+
+	return usage, nil
+}
+
+// RecordUsage marks an entry as launched now
+func RecordUsage(name string) error {
+	usage, err := LoadUsage()
+	if err != nil {
+		return err
+	}
+
+	record := usage[name]
+	record.Count++
+	record.LastUsed = time.Now()
+	usage[name] = record
+
+	path, err := historyPath()
+	if err != nil {
+		return err
+	}
+
+	data, err := yaml.Marshal(usage)
+	if err != nil {
+		return i18n.Errorf("failed to marshal usage history: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return i18n.Errorf("failed to create history directory: %w", err)
+	}
+
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return i18n.Errorf("failed to write usage history: %w", err)
+	}
+
+	return nil
+}
+
+// SortByUsage orders entries by most recent use, keeping unused entries alphabetical
+func SortByUsage(entries []Entry, usage map[string]Usage) {
+	sort.SliceStable(entries, func(i, j int) bool {
+		a, b := usage[entries[i].Name], usage[entries[j].Name]
+		if !a.LastUsed.Equal(b.LastUsed) {
+			return a.LastUsed.After(b.LastUsed)
+		}
+		return entries[i].Name < entries[j].Name
+	})
+}
+
+// Select uses fuzzy finder to select an entry
+func Select(entries []Entry, usage map[string]Usage) (Entry, error) {
+	idx, err := fuzzyfinder.Find(
+		entries,
+		func(i int) string {
+			entry := entries[i]
+			if entry.Recipe {
+				return i18n.Sprintf("%s (recipe)", entry.Name)
+			}
+			return entry.Name
+		},
+		fuzzyfinder.WithPromptString("opsbrew> "),
+		fuzzyfinder.WithPreviewWindow(func(i, w, h int) string {
+			if i == -1 {
+				return ""
+			}
+			entry := entries[i]
+			lines := []string{
+				i18n.Sprintf("Command: opsbrew %s", strings.Join(entry.Args, " ")),
+				i18n.Sprintf("Description: %s", entry.Description),
+			}
+			if record, ok := usage[entry.Name]; ok {
+				lines = append(lines, i18n.Sprintf("Used: %d times (last %s)", record.Count, record.LastUsed.Format("2006-01-02 15:04")))
+			}
+			return strings.Join(lines, "\n")
+		}),
+	)
+	if err != nil {
+		if errors.Is(err, fuzzyfinder.ErrAbort) {
+			return Entry{}, exitcode.ErrCancelled
+		}
+		return Entry{}, err
+	}
+
+	return entries[idx], nil
+}
@@ -0,0 +1,270 @@
+package prompt
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/ktr0731/go-fuzzyfinder"
+	"github.com/nghiadaulau/opsbrew/internal/exitcode"
+	"github.com/nghiadaulau/opsbrew/internal/i18n"
+	"golang.org/x/term"
+)
+
+// AssumeYes answers every confirmation with yes (--confirm)
+var AssumeYes bool
+
+// NonInteractive never reads from the terminal; prompts fall back to their defaults (--non-interactive)
+var NonInteractive bool
+
+// In and Out are the streams prompts read from and write to
+var (
+	In  io.Reader = os.Stdin
+	Out io.Writer = os.Stdout
+)
+
+// find and findMulti open the fuzzy finder; tests replace them
+var (
+	find      = fuzzyfinder.Find
+	findMulti = fuzzyfinder.FindMulti
+)
+
+// reader is shared between prompts so buffered input is not lost
+var reader *bufio.Reader
+
+// readLine reads one line of input, keeping spaces and dropping the line ending
+func readLine() (string, error) {
+	if reader == nil {
+		reader = bufio.NewReader(In)
+	}
+
+	line, err := reader.ReadString('\n')
+	if err != nil {
+		if errors.Is(err, io.EOF) && line != "" {
+			return strings.TrimRight(line, "\r\n"), nil
+		}
+		if errors.Is(err, io.EOF) {
+			return "", io.EOF
+		}
+		return "", exitcode.Errorf(exitcode.General, "Error reading input: %v", err)
+	}
+
+	return strings.TrimRight(line, "\r\n"), nil
+}
+
+// errInputRequired is returned when a prompt has no usable default in non-interactive mode
+func errInputRequired(message string) error {
+	return exitcode.Errorf(exitcode.Validation, "input required for %q but running non-interactively", message)
+}
+
+// RequireInteractive returns an error naming the missing input when prompts are disabled.
+// Commands call it before opening their own pickers, such as the fuzzy finder.
+func RequireInteractive(message string) error {
+	if NonInteractive {
+		return errInputRequired(message)
+	}
+	return nil
+}
+
+// Confirm asks a yes/no question, returning defaultYes on an empty answer
+func Confirm(message string, defaultYes bool) (bool, error) {
+	if AssumeYes {
+		return true, nil
+	}
+	if NonInteractive {
+		return defaultYes, nil
+	}
+
+	hint := i18n.T("[y/N]")
+	if defaultYes {
+		hint = i18n.T("[Y/n]")
+	}
+
+	for {
+		fmt.Fprintf(Out, "%s %s: ", message, hint)
+		answer, err := readLine()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				fmt.Fprintln(Out)
+				return false, exitcode.ErrCancelled
+			}
+			return false, err
+		}
+
+		switch strings.ToLower(strings.TrimSpace(answer)) {
+		case "":
+			return defaultYes, nil
+		case "y", "yes", i18n.T("y"), i18n.T("yes"):
+			return true, nil
+		case "n", "no", i18n.T("n"), i18n.T("no"):
+			return false, nil
+		}
+
+		color.New(color.FgYellow).Fprintln(Out, i18n.T("Please answer yes or no"))
+	}
+}
+
+// Input asks for a line of text, returning defaultValue on an empty answer.
+// validate, when set, is checked before accepting the answer.
+func Input(message, defaultValue string, validate func(string) error) (string, error) {
+	if NonInteractive {
+		if validate != nil {
+			if err := validate(defaultValue); err != nil {
+				return "", errInputRequired(message)
+			}
+		}
+		return defaultValue, nil
+	}
+
+	for {
+		if defaultValue != "" {
+			fmt.Fprintf(Out, "%s [%s]: ", message, defaultValue)
+		} else {
+			fmt.Fprintf(Out, "%s: ", message)
+		}
+
+		answer, err := readLine()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				fmt.Fprintln(Out)
+				return "", exitcode.ErrCancelled
+			}
+			return "", err
+		}
+
+		answer = strings.TrimSpace(answer)
+		if answer == "" {
+			answer = defaultValue
+		}
+
+		if validate != nil {
+			if err := validate(answer); err != nil {
+				color.New(color.FgRed).Fprintln(Out, err)
+				continue
+			}
+		}
+
+		return answer, nil
+	}
+}
+
+// Lines asks for one entry per line until an empty line or end of input.
+// defaults is returned when nothing is entered, and in non-interactive mode.
+func Lines(message string, defaults []string) ([]string, error) {
+	if NonInteractive {
+		if len(defaults) == 0 {
+			return nil, errInputRequired(message)
+		}
+		return defaults, nil
+	}
+
+	fmt.Fprintln(Out, message)
+
+	var lines []string
+	for {
+		fmt.Fprint(Out, "> ")
+		line, err := readLine()
+		if err != nil && !errors.Is(err, io.EOF) {
+			return nil, err
+		}
+		if err != nil {
+			fmt.Fprintln(Out)
+		}
+
+		line = strings.TrimSpace(line)
+		if line == "" {
+			if len(lines) == 0 {
+				return defaults, nil
+			}
+			return lines, nil
+		}
+		lines = append(lines, line)
+	}
+}
+
+// Password asks for a secret without echoing it to the terminal.
+// There is no default secret, so non-interactive mode fails.
+func Password(message string) (string, error) {
+	if NonInteractive {
+		return "", errInputRequired(message)
+	}
+
+	fmt.Fprintf(Out, "%s: ", message)
+
+	// Fall back to plain line input when stdin is not a terminal (e.g. piped)
+	file, ok := In.(*os.File)
+	if !ok || !term.IsTerminal(int(file.Fd())) {
+		secret, err := readLine()
+		if errors.Is(err, io.EOF) {
+			fmt.Fprintln(Out)
+			return "", exitcode.ErrCancelled
+		}
+		return secret, err
+	}
+
+	secret, err := term.ReadPassword(int(file.Fd()))
+	fmt.Fprintln(Out)
+	if err != nil {
+		return "", exitcode.Errorf(exitcode.General, "Error reading input: %v", err)
+	}
+
+	return string(secret), nil
+}
+
+// Select asks the user to pick one option with the fuzzy finder, returning its index.
+// With AssumeYes or NonInteractive a valid defaultIndex is taken without asking;
+// non-interactive mode fails when there is none.
+func Select(message string, options []string, defaultIndex int) (int, error) {
+	hasDefault := defaultIndex >= 0 && defaultIndex < len(options)
+	if hasDefault && (AssumeYes || NonInteractive) {
+		return defaultIndex, nil
+	}
+	if NonInteractive {
+		return -1, errInputRequired(message)
+	}
+
+	idx, err := find(
+		options,
+		func(i int) string {
+			return options[i]
+		},
+		fuzzyfinder.WithHeader(message),
+	)
+	if err != nil {
+		if errors.Is(err, fuzzyfinder.ErrAbort) {
+			return -1, exitcode.ErrCancelled
+		}
+		return -1, err
+	}
+
+	return idx, nil
+}
+
+// MultiSelect asks the user to pick any number of options with the fuzzy finder (Tab to mark),
+// returning their indexes. With AssumeYes non-empty defaults are taken without asking;
+// in non-interactive mode defaults is always returned.
+func MultiSelect(message string, options []string, defaults []int) ([]int, error) {
+	if NonInteractive || (AssumeYes && len(defaults) > 0) {
+		return defaults, nil
+	}
+
+	idxs, err := findMulti(
+		options,
+		func(i int) string {
+			return options[i]
+		},
+		fuzzyfinder.WithHeader(message),
+	)
+	if err != nil {
+		if errors.Is(err, fuzzyfinder.ErrAbort) {
+			return nil, exitcode.ErrCancelled
+		}
+		return nil, err
+	}
+
+	return idxs, nil
+}
@@ -0,0 +1,321 @@
+package prompt
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ktr0731/go-fuzzyfinder"
+	"github.com/nghiadaulau/opsbrew/internal/exitcode"
+	"github.com/nghiadaulau/opsbrew/internal/i18n"
+)
+
+// withInput feeds input to prompts for the duration of a test
+func withInput(t *testing.T, input string) {
+	t.Helper()
+
+	in, out := In, Out
+	In, Out, reader = strings.NewReader(input), &bytes.Buffer{}, nil
+	t.Cleanup(func() {
+		In, Out, reader = in, out, nil
+		AssumeYes, NonInteractive = false, false
+		i18n.SetLocale(i18n.DefaultLocale)
+	})
+}
+
+func TestConfirm(t *testing.T) {
+	tests := []struct {
+		name       string
+		locale     string
+		input      string
+		defaultYes bool
+		want       bool
+		wantErr    error
+	}{
+		{"yes", "en", "y\n", false, true, nil},
+		{"full word with spaces", "en", "  Yes  \n", false, true, nil},
+		{"no", "en", "no\n", true, false, nil},
+		{"empty takes default no", "en", "\n", false, false, nil},
+		{"empty takes default yes", "en", "\n", true, true, nil},
+		{"reasks until answered", "en", "maybe\ny\n", false, true, nil},
+		{"last line without newline", "en", "y", false, true, nil},
+		{"eof cancels", "en", "", true, false, exitcode.ErrCancelled},
+		{"localized yes", "vi", "c\n", false, true, nil},
+		{"localized no", "vi", "không\n", true, false, nil},
+		{"english still accepted", "vi", "yes\n", false, true, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			withInput(t, tt.input)
+			i18n.SetLocale(tt.locale)
+
+			got, err := Confirm("Continue?", tt.defaultYes)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Confirm() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Confirm() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConfirmFlags(t *testing.T) {
+	withInput(t, "n\n")
+	AssumeYes = true
+	if ok, err := Confirm("Continue?", false); err != nil || !ok {
+		t.Errorf("Confirm() with AssumeYes = %v, %v, want true", ok, err)
+	}
+
+	withInput(t, "y\n")
+	AssumeYes, NonInteractive = false, true
+	if ok, err := Confirm("Continue?", false); err != nil || ok {
+		t.Errorf("Confirm() with NonInteractive = %v, %v, want default false", ok, err)
+	}
+}
+
+func TestInput(t *testing.T) {
+	tests := []struct {
+		name         string
+		input        string
+		defaultValue string
+		want         string
+		wantErr      error
+	}{
+		{"keeps inner spaces", "daily sync workflow\n", "", "daily sync workflow", nil},
+		{"trims surrounding spaces", "  value  \r\n", "", "value", nil},
+		{"empty takes default", "\n", "main", "main", nil},
+		{"eof cancels", "", "main", "", exitcode.ErrCancelled},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			withInput(t, tt.input)
+
+			got, err := Input("Value", tt.defaultValue, nil)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Input() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Input() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInputValidate(t *testing.T) {
+	notEmpty := func(value string) error {
+		if value == "" {
+			return errors.New("value is required")
+		}
+		return nil
+	}
+
+	withInput(t, "\nsecond try\n")
+	got, err := Input("Value", "", notEmpty)
+	if err != nil || got != "second try" {
+		t.Errorf("Input() = %q, %v, want %q", got, err, "second try")
+	}
+
+	withInput(t, "")
+	NonInteractive = true
+	if _, err := Input("Value", "", notEmpty); exitcode.KindOf(err) != exitcode.Validation {
+		t.Errorf("Input() non-interactive without valid default error = %v, want validation error", err)
+	}
+}
+
+func TestLines(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		defaults []string
+		want     []string
+	}{
+		{"stops at empty line", "git fetch --all\n  git pull --rebase  \n\nignored\n", nil, []string{"git fetch --all", "git pull --rebase"}},
+		{"stops at eof", "kubectl get pods", nil, []string{"kubectl get pods"}},
+		{"nothing entered", "\n", nil, nil},
+		{"nothing entered keeps defaults", "\n", []string{"make test"}, []string{"make test"}},
+		{"eof keeps defaults", "", []string{"make test"}, []string{"make test"}},
+		{"entries replace defaults", "make lint\n\n", []string{"make test"}, []string{"make lint"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			withInput(t, tt.input)
+
+			got, err := Lines("Commands:", tt.defaults)
+			if err != nil {
+				t.Fatalf("Lines() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Lines() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLinesNonInteractive(t *testing.T) {
+	withInput(t, "ignored\n")
+	NonInteractive = true
+
+	defaults := []string{"git fetch --all"}
+	if got, err := Lines("Commands:", defaults); err != nil || !reflect.DeepEqual(got, defaults) {
+		t.Errorf("Lines() non-interactive = %q, %v, want %q", got, err, defaults)
+	}
+	if _, err := Lines("Commands:", nil); exitcode.KindOf(err) != exitcode.Validation {
+		t.Errorf("Lines() non-interactive without defaults error = %v, want validation error", err)
+	}
+}
+
+func TestPassword(t *testing.T) {
+	withInput(t, "s3cret value\n")
+	if got, err := Password("Token"); err != nil || got != "s3cret value" {
+		t.Errorf("Password() = %q, %v, want %q", got, err, "s3cret value")
+	}
+
+	withInput(t, "")
+	if _, err := Password("Token"); !errors.Is(err, exitcode.ErrCancelled) {
+		t.Errorf("Password() at eof error = %v, want %v", err, exitcode.ErrCancelled)
+	}
+
+	withInput(t, "s3cret\n")
+	NonInteractive = true
+	if _, err := Password("Token"); exitcode.KindOf(err) != exitcode.Validation {
+		t.Errorf("Password() non-interactive error = %v, want validation error", err)
+	}
+}
+
+// withFinder replaces the fuzzy finder for the duration of a test, recording whether it was opened
+func withFinder(t *testing.T, idxs []int, err error) *bool {
+	t.Helper()
+
+	opened := false
+	prevFind, prevFindMulti := find, findMulti
+	find = func(interface{}, func(int) string, ...fuzzyfinder.Option) (int, error) {
+		opened = true
+		if err != nil {
+			return 0, err
+		}
+		return idxs[0], nil
+	}
+	findMulti = func(interface{}, func(int) string, ...fuzzyfinder.Option) ([]int, error) {
+		opened = true
+		return idxs, err
+	}
+	t.Cleanup(func() {
+		find, findMulti = prevFind, prevFindMulti
+	})
+
+	return &opened
+}
+
+func TestSelect(t *testing.T) {
+	options := []string{"dev", "staging", "prod"}
+
+	tests := []struct {
+		name           string
+		assumeYes      bool
+		nonInteractive bool
+		defaultIndex   int
+		findErr        error
+		want           int
+		wantOpened     bool
+		wantErr        error
+	}{
+		{"picks with the finder", false, false, 0, nil, 2, true, nil},
+		{"abort cancels", false, false, 0, fuzzyfinder.ErrAbort, -1, true, exitcode.ErrCancelled},
+		{"assume yes takes default", true, false, 1, nil, 1, false, nil},
+		{"assume yes without default asks", true, false, -1, nil, 2, true, nil},
+		{"non-interactive takes default", false, true, 1, nil, 1, false, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			withInput(t, "")
+			opened := withFinder(t, []int{2}, tt.findErr)
+			AssumeYes, NonInteractive = tt.assumeYes, tt.nonInteractive
+
+			got, err := Select("Environment", options, tt.defaultIndex)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Select() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Select() = %d, want %d", got, tt.want)
+			}
+			if *opened != tt.wantOpened {
+				t.Errorf("Select() opened finder = %v, want %v", *opened, tt.wantOpened)
+			}
+		})
+	}
+}
+
+func TestSelectNonInteractiveWithoutDefault(t *testing.T) {
+	withInput(t, "")
+	opened := withFinder(t, []int{0}, nil)
+	NonInteractive = true
+
+	for _, defaultIndex := range []int{-1, 3} {
+		if _, err := Select("Environment", []string{"dev", "staging", "prod"}, defaultIndex); exitcode.KindOf(err) != exitcode.Validation {
+			t.Errorf("Select() non-interactive with default %d error = %v, want validation error", defaultIndex, err)
+		}
+	}
+	if *opened {
+		t.Error("Select() opened the finder in non-interactive mode")
+	}
+}
+
+func TestMultiSelect(t *testing.T) {
+	options := []string{"api", "worker", "web"}
+
+	tests := []struct {
+		name           string
+		assumeYes      bool
+		nonInteractive bool
+		defaults       []int
+		findErr        error
+		want           []int
+		wantOpened     bool
+		wantErr        error
+	}{
+		{"picks with the finder", false, false, nil, nil, []int{0, 2}, true, nil},
+		{"abort cancels", false, false, nil, fuzzyfinder.ErrAbort, nil, true, exitcode.ErrCancelled},
+		{"assume yes takes defaults", true, false, []int{1}, nil, []int{1}, false, nil},
+		{"assume yes without defaults asks", true, false, nil, nil, []int{0, 2}, true, nil},
+		{"non-interactive takes defaults", false, true, []int{1}, nil, []int{1}, false, nil},
+		{"non-interactive without defaults picks nothing", false, true, nil, nil, nil, false, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			withInput(t, "")
+			opened := withFinder(t, []int{0, 2}, tt.findErr)
+			AssumeYes, NonInteractive = tt.assumeYes, tt.nonInteractive
+
+			got, err := MultiSelect("Services", options, tt.defaults)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("MultiSelect() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MultiSelect() = %v, want %v", got, tt.want)
+			}
+			if *opened != tt.wantOpened {
+				t.Errorf("MultiSelect() opened finder = %v, want %v", *opened, tt.wantOpened)
+			}
+		})
+	}
+}
+
+func TestRequireInteractive(t *testing.T) {
+	withInput(t, "")
+	if err := RequireInteractive("pod"); err != nil {
+		t.Errorf("RequireInteractive() = %v, want nil", err)
+	}
+
+	NonInteractive = true
+	if err := RequireInteractive("pod"); exitcode.KindOf(err) != exitcode.Validation {
+		t.Errorf("RequireInteractive() non-interactive = %v, want validation error", err)
+	}
+}
@@ -0,0 +1,190 @@
+package runner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/nghiadaulau/opsbrew/internal/exitcode"
+)
+
+// Verbose enables echoing of every external command before it runs
+var Verbose bool
+
+// Echo is where verbose command lines are written
+var Echo io.Writer = os.Stderr
+
+// Timeout limits every command when non-zero, overriding configured defaults
+var Timeout time.Duration
+
+// DefaultTimeout limits commands whose tool has no entry in ToolTimeouts
+var DefaultTimeout time.Duration
+
+// ToolTimeouts limits commands per program name (e.g. "kubectl")
+var ToolTimeouts = map[string]time.Duration{}
+
+// waitDelay bounds how long a killed command may hold its output pipes open
+const waitDelay = 2 * time.Second
+
+// Cmd is an external command that is echoed when verbose output is enabled
+// and killed once its timeout expires. Only Run and Output start it, so every
+// command goes through the echo and the timeout.
+type Cmd struct {
+	Stdin  io.Reader
+	Stdout io.Writer
+	Stderr io.Writer
+	Env    []string
+	Dir    string
+
+	// LongRunning exempts interactive or streaming commands from configured
+	// default timeouts; an explicit Timeout still applies
+	LongRunning bool
+
+	cmd *exec.Cmd
+}
+
+// Command returns a Cmd to execute the named program with the given arguments
+func Command(name string, arg ...string) *Cmd {
+	return &Cmd{cmd: exec.Command(name, arg...)}
+}
+
+// Run echoes and runs the command, waiting for it to complete
+func (c *Cmd) Run() error {
+	c.echo()
+
+	timeout := c.timeout()
+	if timeout <= 0 {
+		return c.prepare(nil).Run()
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	return c.timeoutError(ctx, timeout, c.prepare(ctx).Run())
+}
+
+// Output echoes and runs the command, returning its standard output
+func (c *Cmd) Output() ([]byte, error) {
+	c.echo()
+
+	timeout := c.timeout()
+	if timeout <= 0 {
+		return c.prepare(nil).Output()
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	output, err := c.prepare(ctx).Output()
+	return output, c.timeoutError(ctx, timeout, err)
+}
+
+// timeout returns the deadline that applies to this command, or zero for none
+func (c *Cmd) timeout() time.Duration {
+	if Timeout > 0 {
+		return Timeout
+	}
+	if c.LongRunning {
+		return 0
+	}
+	if d, ok := ToolTimeouts[c.tool()]; ok {
+		return d
+	}
+	return DefaultTimeout
+}
+
+// tool returns the program name without its directory
+func (c *Cmd) tool() string {
+	return filepath.Base(c.cmd.Args[0])
+}
+
+// prepare copies the caller's settings onto the underlying command,
+// replacing it with one that is killed when ctx is done if ctx is set
+func (c *Cmd) prepare(ctx context.Context) *exec.Cmd {
+	cmd := c.cmd
+	if ctx != nil {
+		cmd = exec.CommandContext(ctx, c.cmd.Path)
+		cmd.Args = c.cmd.Args
+		cmd.WaitDelay = waitDelay
+	}
+	cmd.Stdin = c.Stdin
+	cmd.Stdout = c.Stdout
+	cmd.Stderr = c.Stderr
+	cmd.Env = c.Env
+	cmd.Dir = c.Dir
+	return cmd
+}
+
+// timeoutError replaces err with a timeout error when ctx expired
+func (c *Cmd) timeoutError(ctx context.Context, timeout time.Duration, err error) error {
+	if err != nil && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return exitcode.Errorf(exitcode.Timeout, "%s killed after timeout of %s", c.tool(), timeout)
+	}
+	return err
+}
+
+// String returns the command line as it would be typed in a shell
+func (c *Cmd) String() string {
+	parts := envDelta(c.Env)
+	for _, arg := range c.cmd.Args {
+		parts = append(parts, quote(arg))
+	}
+	return strings.Join(parts, " ")
+}
+
+// echo prints the command line, environment changes, and working directory
+func (c *Cmd) echo() {
+	if !Verbose {
+		return
+	}
+
+	dir := c.Dir
+	if dir == "" {
+		dir, _ = os.Getwd()
+	}
+
+	color.New(color.Faint).Fprintf(Echo, "[exec] %s $ %s\n", dir, c.String())
+}
+
+// envDelta returns the entries of env that are not already in the current environment
+func envDelta(env []string) []string {
+	if env == nil {
+		return nil
+	}
+
+	current := map[string]bool{}
+	for _, kv := range os.Environ() {
+		current[kv] = true
+	}
+
+	var delta []string
+	for _, kv := range env {
+		if current[kv] {
+			continue
+		}
+		if i := strings.Index(kv, "="); i >= 0 {
+			delta = append(delta, kv[:i+1]+quote(kv[i+1:]))
+		} else {
+			delta = append(delta, quote(kv))
+		}
+	}
+	return delta
+}
+
+// quote wraps s in single quotes when it contains shell metacharacters
+func quote(s string) string {
+	if s == "" {
+		return "''"
+	}
+	if !strings.ContainsAny(s, " \t\n'\"\\$`!*?[]{}()<>|&;#~") {
+		return s
+	}
+	return fmt.Sprintf("'%s'", strings.ReplaceAll(s, "'", `'\''`))
+}
@@ -0,0 +1,83 @@
+package runner
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nghiadaulau/opsbrew/internal/exitcode"
+)
+
+// withTimeouts sets the package timeouts for the duration of a test
+func withTimeouts(t *testing.T, timeout, defaultTimeout time.Duration, tools map[string]time.Duration) {
+	t.Helper()
+
+	prevTimeout, prevDefault, prevTools := Timeout, DefaultTimeout, ToolTimeouts
+	Timeout, DefaultTimeout, ToolTimeouts = timeout, defaultTimeout, tools
+	t.Cleanup(func() {
+		Timeout, DefaultTimeout, ToolTimeouts = prevTimeout, prevDefault, prevTools
+	})
+}
+
+func TestTimeoutPrecedence(t *testing.T) {
+	tools := map[string]time.Duration{"kubectl": 60 * time.Second}
+
+	tests := []struct {
+		name        string
+		timeout     time.Duration
+		defaultTo   time.Duration
+		program     string
+		longRunning bool
+		want        time.Duration
+	}{
+		{"none configured", 0, 0, "git", false, 0},
+		{"default applies", 0, 10 * time.Second, "git", false, 10 * time.Second},
+		{"tool overrides default", 0, 10 * time.Second, "kubectl", false, 60 * time.Second},
+		{"tool matched by base name", 0, 0, "/usr/local/bin/kubectl", false, 60 * time.Second},
+		{"flag overrides tool", 5 * time.Second, 10 * time.Second, "kubectl", false, 5 * time.Second},
+		{"long running skips tool", 0, 10 * time.Second, "kubectl", true, 0},
+		{"long running skips default", 0, 10 * time.Second, "git", true, 0},
+		{"flag still limits long running", 5 * time.Second, 0, "kubectl", true, 5 * time.Second},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			withTimeouts(t, tt.timeout, tt.defaultTo, tools)
+
+			cmd := Command(tt.program)
+			cmd.LongRunning = tt.longRunning
+			if got := cmd.timeout(); got != tt.want {
+				t.Errorf("timeout() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRunTimeout(t *testing.T) {
+	withTimeouts(t, 100*time.Millisecond, 0, map[string]time.Duration{})
+
+	start := time.Now()
+	err := Command("sleep", "5").Run()
+	if elapsed := time.Since(start); elapsed > 3*time.Second {
+		t.Fatalf("Run() took %s, want it killed after the timeout", elapsed)
+	}
+
+	if got := exitcode.Code(err); got != exitcode.CodeTimeout {
+		t.Errorf("Code(%v) = %d, want %d", err, got, exitcode.CodeTimeout)
+	}
+	if err == nil || !strings.Contains(err.Error(), "sleep killed after timeout of 100ms") {
+		t.Errorf("Run() error = %v, want a timeout message naming sleep", err)
+	}
+}
+
+func TestOutputWithinTimeout(t *testing.T) {
+	withTimeouts(t, 5*time.Second, 0, map[string]time.Duration{})
+
+	output, err := Command("echo", "done").Output()
+	if err != nil {
+		t.Fatalf("Output() error = %v", err)
+	}
+	if got := strings.TrimSpace(string(output)); got != "done" {
+		t.Errorf("Output() = %q, want %q", got, "done")
+	}
+}
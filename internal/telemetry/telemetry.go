@@ -0,0 +1,193 @@
+package telemetry
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"time"
+
+	"github.com/mitchellh/go-homedir"
+	"github.com/nghiadaulau/opsbrew/internal/i18n"
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultBatchSize is the number of events sent per request when not configured
+const DefaultBatchSize = 20
+
+// maxPending caps the local queue when no endpoint is reachable
+const maxPending = 1000
+
+// retryAfter is how long sending is skipped after a failed attempt
+const retryAfter = time.Hour
+
+// Event represents a single anonymous usage event.
+// Only the subcommand path is recorded, never arguments or identifiers.
+type Event struct {
+	Command   string    `yaml:"command" json:"command"`
+	Version   string    `yaml:"version" json:"version"`
+	OS        string    `yaml:"os" json:"os"`
+	Arch      string    `yaml:"arch" json:"arch"`
+	Timestamp time.Time `yaml:"timestamp" json:"timestamp"`
+}
+
+// queue is the on-disk state of pending events
+type queue struct {
+	Events []Event `yaml:"events"`
+	// LastFailure is when sending last failed, so an unreachable endpoint
+	// does not slow down every command
+	LastFailure time.Time `yaml:"last_failure,omitempty"`
+}
+
+// queuePath returns the path of the pending events file
+func queuePath() (string, error) {
+	home, err := homedir.Dir()
+	if err != nil {
+		return "", i18n.Errorf("failed to get home directory: %w", err)
+	}
+	return filepath.Join(home, ".opsbrew", "telemetry.yaml"), nil
+}
+
+// Pending returns the events waiting to be sent
+func Pending() ([]Event, error) {
+	q, err := load()
+	if err != nil {
+		return nil, err
+	}
+	return q.Events, nil
+}
+
+// load reads the queue, returning an empty one when none exists
+func load() (*queue, error) {
+	path, err := queuePath()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return &queue{}, nil
+		}
+		return nil, i18n.Errorf("failed to read telemetry queue: %w", err)
+	}
+
+	var q queue
+	if err := yaml.Unmarshal(data, &q); err != nil {
+		return nil, i18n.Errorf("failed to parse telemetry queue: %w", err)
+	}
+
+	return &q, nil
+}
+
+// save writes the queue
+func save(q *queue) error {
+	path, err := queuePath()
+	if err != nil {
+		return err
+	}
+
+	data, err := yaml.Marshal(q)
+	if err != nil {
+		return i18n.Errorf("failed to marshal telemetry queue: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return i18n.Errorf("failed to create telemetry directory: %w", err)
+	}
+
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return i18n.Errorf("failed to write telemetry queue: %w", err)
+	}
+
+	return nil
+}
+
+// Record queues a usage event for the given subcommand path
+func Record(command, version string) error {
+	q, err := load()
+	if err != nil {
+		return err
+	}
+
+	q.Events = append(q.Events, Event{
+		Command:   command,
+		Version:   version,
+		OS:        runtime.GOOS,
+		Arch:      runtime.GOARCH,
+		Timestamp: time.Now().UTC().Truncate(time.Hour),
+	})
+
+	// Drop the oldest events rather than growing without bound
+	if len(q.Events) > maxPending {
+		q.Events = q.Events[len(q.Events)-maxPending:]
+	}
+
+	return save(q)
+}
+
+// Flush sends pending events to endpoint once at least batchSize are queued.
+// After a failed attempt, sending is skipped until retryAfter has passed.
+func Flush(endpoint string, batchSize int) error {
+	if endpoint == "" {
+		return nil
+	}
+	if batchSize <= 0 {
+		batchSize = DefaultBatchSize
+	}
+
+	q, err := load()
+	if err != nil {
+		return err
+	}
+	if len(q.Events) < batchSize || time.Since(q.LastFailure) < retryAfter {
+		return nil
+	}
+
+	if err := send(endpoint, q.Events); err != nil {
+		q.LastFailure = time.Now()
+		if saveErr := save(q); saveErr != nil {
+			return saveErr
+		}
+		return err
+	}
+
+	return Clear()
+}
+
+// send posts events to endpoint as a single JSON batch
+func send(endpoint string, events []Event) error {
+	body, err := json.Marshal(map[string]interface{}{"events": events})
+	if err != nil {
+		return i18n.Errorf("failed to marshal telemetry batch: %w", err)
+	}
+
+	client := &http.Client{Timeout: 2 * time.Second}
+	resp, err := client.Post(endpoint, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return i18n.Errorf("failed to send telemetry: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return i18n.Errorf("failed to send telemetry: unexpected status %s", resp.Status)
+	}
+
+	return nil
+}
+
+// Clear removes all pending events
+func Clear() error {
+	path, err := queuePath()
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return i18n.Errorf("failed to clear telemetry queue: %w", err)
+	}
+
+	return nil
+}
@@ -1,13 +1,14 @@
 package templates
 
 import (
-	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
 	"text/template"
 
 	"github.com/nghiadaulau/opsbrew/internal/config"
+	"github.com/nghiadaulau/opsbrew/internal/exitcode"
+	"github.com/nghiadaulau/opsbrew/internal/i18n"
 )
 
 // Template represents a project template
@@ -74,7 +75,7 @@ func InitializeTemplate(templateName, projectName, outputDir string, force bool,
 	}
 
 	if selectedTemplate == nil {
-		return fmt.Errorf("template '%s' not found", templateName)
+		return exitcode.Errorf(exitcode.Validation, "template '%s' not found", templateName)
 	}
 
 	// Determine output directory
@@ -89,7 +90,7 @@ func InitializeTemplate(templateName, projectName, outputDir string, force bool,
 	// Create output directory if it doesn't exist
 	if outputDir != "." {
 		if err := os.MkdirAll(outputDir, 0755); err != nil {
-			return fmt.Errorf("failed to create output directory: %w", err)
+			return i18n.Errorf("failed to create output directory: %w", err)
 		}
 	}
 
@@ -106,40 +107,40 @@ func InitializeTemplate(templateName, projectName, outputDir string, force bool,
 		
 		// Check if file exists
 		if _, err := os.Stat(filePath); err == nil && !force {
-			return fmt.Errorf("file %s already exists (use --force to overwrite)", filePath)
+			return i18n.Errorf("file %s already exists (use --force to overwrite)", filePath)
 		}
 
 		if file.IsDir {
 			// Create directory
 			if err := os.MkdirAll(filePath, file.Mode); err != nil {
-				return fmt.Errorf("failed to create directory %s: %w", filePath, err)
+				return i18n.Errorf("failed to create directory %s: %w", filePath, err)
 			}
 		} else {
 			// Create file
 			dir := filepath.Dir(filePath)
 			if err := os.MkdirAll(dir, 0755); err != nil {
-				return fmt.Errorf("failed to create directory %s: %w", dir, err)
+				return i18n.Errorf("failed to create directory %s: %w", dir, err)
 			}
 
 			// Parse and execute template
 			tmpl, err := template.New(filePath).Parse(file.Content)
 			if err != nil {
-				return fmt.Errorf("failed to parse template for %s: %w", filePath, err)
+				return i18n.Errorf("failed to parse template for %s: %w", filePath, err)
 			}
 
 			f, err := os.Create(filePath)
 			if err != nil {
-				return fmt.Errorf("failed to create file %s: %w", filePath, err)
+				return i18n.Errorf("failed to create file %s: %w", filePath, err)
 			}
 			defer f.Close()
 
 			if err := tmpl.Execute(f, data); err != nil {
-				return fmt.Errorf("failed to execute template for %s: %w", filePath, err)
+				return i18n.Errorf("failed to execute template for %s: %w", filePath, err)
 			}
 
 			// Set file permissions
 			if err := os.Chmod(filePath, file.Mode); err != nil {
-				return fmt.Errorf("failed to set permissions for %s: %w", filePath, err)
+				return i18n.Errorf("failed to set permissions for %s: %w", filePath, err)
 			}
 		}
 	}